@@ -0,0 +1,69 @@
+package modular
+
+// AddMod returns (a + b) mod m.
+//
+// Unlike Congruent(a + b), the sum isn't rounded before it's reduced;
+// a and b are reduced exactly, and their sum mod m is rounded once, to nearest.
+// Like Congruent, a sum just below a multiple of m can round to m.
+//
+// Special cases:
+//		Modulus{NaN}.AddMod(a, b) = NaN
+//		Modulus{±Inf}.AddMod(a, b) = Congruent(a + b)
+//		Modulus{m}.AddMod(±Inf, b) = NaN
+//		Modulus{m}.AddMod(a, ±Inf) = NaN
+//		Modulus{m}.AddMod(NaN, b) = NaN
+//		Modulus{m}.AddMod(a, NaN) = NaN
+func (m Modulus[T]) AddMod(a, b T) T {
+	switch {
+	case m.mod == 0 || m.mod != m.mod || a != a || b != b || isInf(a) || isInf(b):
+		return nan[T]()
+	case isInf(m.mod):
+		return m.Congruent(a + b)
+	}
+
+	// The remainders are exact and in [-m/2, m/2], so their sum is in [-m, m], and hi + lo is exact.
+	hi, lo := twoSum(m.Remainder(a), m.Remainder(b))
+	switch {
+	case hi == m.mod && lo >= 0:
+		return 0 // Both remainders are m/2.
+	case hi > 0 || hi == 0 && lo >= 0:
+		return hi // hi is hi + lo rounded to nearest.
+	}
+
+	// m + hi + lo, where m + hi rounds, but its error and lo are much smaller than an ulp of the result.
+	// Rounding their sum to odd keeps it on the same side of every point the final rounding can tie on,
+	// so only the final sum rounds.
+	s, e := twoSum(m.mod, hi)
+	return s + roundOdd(twoSum(e, lo))
+}
+
+// SubMod returns (a - b) mod m, rounded once like AddMod.
+//
+// Special cases:
+//		Modulus{NaN}.SubMod(a, b) = NaN
+//		Modulus{±Inf}.SubMod(a, b) = Congruent(a - b)
+//		Modulus{m}.SubMod(±Inf, b) = NaN
+//		Modulus{m}.SubMod(a, ±Inf) = NaN
+//		Modulus{m}.SubMod(NaN, b) = NaN
+//		Modulus{m}.SubMod(a, NaN) = NaN
+func (m Modulus[T]) SubMod(a, b T) T {
+	return m.AddMod(a, -b)
+}
+
+// roundOdd returns s + e, which must be exact as from twoSum, rounded to odd;
+// s, or its neighbour towards e if s's last bit is even and e isn't 0.
+func roundOdd[T Float](s, e T) T {
+	b := absBits(s)
+	if e == 0 || b&1 != 0 {
+		return s
+	}
+	if (e > 0) == (s > 0) {
+		b++
+	} else {
+		b--
+	}
+	if s < 0 {
+		return -floatFromBits[T](b)
+	}
+	return floatFromBits[T](b)
+}
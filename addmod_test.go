@@ -0,0 +1,124 @@
+package modular_test
+
+import (
+	"math"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/stewi1014/modular"
+	"github.com/stewi1014/modular/modulartest"
+)
+
+func TestModulus_AddMod(t *testing.T) {
+	tests := []struct {
+		name    string
+		modulus float64
+		a, b    float64
+		add     float64
+		sub     float64
+	}{
+		{
+			name:    "Basic test",
+			modulus: 10,
+			a:       7, b: 5,
+			add: 2, sub: 2,
+		},
+		{
+			name:    "Large numbers",
+			modulus: 3,
+			a:       1e17, b: 1,
+			add: 2, sub: 0,
+		},
+		{
+			name:    "Cancelling numbers",
+			modulus: 3,
+			a:       1e17, b: -1e17,
+			add: 0, sub: 2,
+		},
+		{
+			name:    "Fractional numbers",
+			modulus: 1,
+			a:       0.1, b: 0.2,
+			add: 0.30000000000000004, sub: 0.9,
+		},
+		{
+			name:    "Small negative number",
+			modulus: 1,
+			a:       -1e-300, b: 0,
+			add: 1, sub: 1,
+		},
+		{
+			name:    "Halves",
+			modulus: 1,
+			a:       0.5, b: 1.5,
+			add: 0, sub: 0,
+		},
+		{
+			name:    "Infinite modulus",
+			modulus: math.Inf(1),
+			a:       1, b: -2,
+			add: math.Inf(1), sub: 3,
+		},
+		{
+			name:    "Infinite number",
+			modulus: 1,
+			a:       math.Inf(1), b: 1,
+			add: math.NaN(), sub: math.NaN(),
+		},
+		{
+			name:    "NaN",
+			modulus: 1,
+			a:       1, b: math.NaN(),
+			add: math.NaN(), sub: math.NaN(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := modular.NewModulus(tt.modulus)
+			if got := m.AddMod(tt.a, tt.b); got != tt.add && !(math.IsNaN(got) && math.IsNaN(tt.add)) {
+				t.Errorf("Modulus{%v}.AddMod(%v, %v) = %v, want %v", tt.modulus, tt.a, tt.b, got, tt.add)
+			}
+			if got := m.SubMod(tt.a, tt.b); got != tt.sub && !(math.IsNaN(got) && math.IsNaN(tt.sub)) {
+				t.Errorf("Modulus{%v}.SubMod(%v, %v) = %v, want %v", tt.modulus, tt.a, tt.b, got, tt.sub)
+			}
+		})
+	}
+}
+
+func TestModulus_AddModRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < randomTestNum/4; i++ {
+		mod64 := math.Abs(randomFloat64(r))
+		a64, b64 := nearFloat64(r, mod64), nearFloat64(r, mod64)
+		switch r.Intn(4) {
+		case 0:
+			a64 = randomFloat64(r)
+		case 1:
+			// Nearly cancelling numbers.
+			b64 = -a64 + nearFloat64(r, mod64)
+		}
+		mod32 := float32(math.Abs(float64(randomFloat32(r))))
+		a32, b32 := randomFloat32(r), randomFloat32(r)
+		if mod64 == 0 || mod32 == 0 || math.IsInf(a64, 0) || math.IsInf(b64, 0) || a64 != a64 || b64 != b64 {
+			continue
+		}
+
+		m64 := modular.NewModulus(mod64)
+		bm := new(big.Rat).SetFloat64(mod64)
+		ba, bb := new(big.Rat).SetFloat64(a64), new(big.Rat).SetFloat64(b64)
+		if want, _ := modulartest.CongruentRat(new(big.Rat).Add(ba, bb), bm).Float64(); m64.AddMod(a64, b64) != want {
+			t.Fatalf("Modulus{%v}.AddMod(%v, %v) = %v, want %v", mod64, a64, b64, m64.AddMod(a64, b64), want)
+		}
+		if want, _ := modulartest.CongruentRat(new(big.Rat).Sub(ba, bb), bm).Float64(); m64.SubMod(a64, b64) != want {
+			t.Fatalf("Modulus{%v}.SubMod(%v, %v) = %v, want %v", mod64, a64, b64, m64.SubMod(a64, b64), want)
+		}
+
+		m32 := modular.NewModulus(mod32)
+		bm = new(big.Rat).SetFloat64(float64(mod32))
+		ba, bb = new(big.Rat).SetFloat64(float64(a32)), new(big.Rat).SetFloat64(float64(b32))
+		if want, _ := modulartest.CongruentRat(new(big.Rat).Add(ba, bb), bm).Float32(); m32.AddMod(a32, b32) != want {
+			t.Fatalf("Modulus{%v}.AddMod(%v, %v) = %v, want %v", mod32, a32, b32, m32.AddMod(a32, b32), want)
+		}
+	}
+}
@@ -0,0 +1,155 @@
+package modular
+
+//go:generate go run angle_gen.go
+
+import (
+	"math"
+	"math/bits"
+	"unsafe"
+)
+
+// NewAngleModulus creates a new AngleModulus.
+func NewAngleModulus[T Float]() AngleModulus[T] {
+	return AngleModulus[T]{
+		mod: T(2 * math.Pi),
+	}
+}
+
+// AngleModulus reduces angles in radians by 2π.
+//
+// A Modulus of 2*math.Pi reduces by the float nearest 2π, which is off by a little under 2**-51,
+// so the error in the result grows with the size of the angle; for angles as large as 1e300 it is meaningless.
+// AngleModulus reduces by 2π to several hundred bits, and returns the correctly rounded result for any finite angle.
+//
+// Like a Modulus, it relies on pre-computed powers of two;
+// the bits of 1/(2π) beginning at 2**-e are the fraction of 2**e / (2π), so a window of them gives 2**e mod 2π directly.
+type AngleModulus[T Float] struct {
+	mod T
+}
+
+// Mod returns the modulus, 2π rounded to the nearest float.
+func (m AngleModulus[T]) Mod() T {
+	return m.mod
+}
+
+// Congruent returns n mod 2π, rounded to the nearest float.
+//
+// The result satisfies 0 <= r <= Mod();
+// it is only equal to Mod() if the exact result is within rounding of 2π.
+//
+// Special cases:
+//		AngleModulus.Congruent(±Inf) = NaN
+//		AngleModulus.Congruent(NaN) = NaN
+func (m AngleModulus[T]) Congruent(n T) T {
+	if n >= 0 && n < m.mod {
+		// m.mod is 2π rounded, so anything smaller is also smaller than 2π.
+		return n
+	}
+
+	nfr, nexp := frexp(n)
+	if nexp == maxExp(unsafe.Sizeof(n)) {
+		return nan[T]()
+	}
+	if nexp == 0 {
+		nexp = 1 // Denormalised numbers share the smallest exponent.
+	}
+	exp := int(nexp) - int(maxExp(unsafe.Sizeof(n))/2) - int(fractionBits(unsafe.Sizeof(n)))
+
+	// With |n| = nfr * 2**exp, the fraction of |n| / (2π) comes from the bits of 1/(2π) from 2**-(exp+1) down;
+	// the bits before them give integer multiples of 2π when multiplied by nfr * 2**exp.
+	start := uint(0)
+	if exp >= 0 {
+		start = uint(exp)
+	}
+	w, off := start/64, start%64
+	var x [4]uint64
+	for i := range x {
+		x[i] = invTwoPi[w+uint(i)]<<off | invTwoPi[w+uint(i)+1]>>(64-off)
+	}
+
+	// p = nfr * x, which is nfr * 2**exp / (2π) in units of 2**-256 for exp >= 0.
+	var p [5]uint64
+	for i := 3; i >= 0; i-- {
+		hi, lo := bits.Mul64(x[i], nfr)
+		var c uint64
+		p[i+1], c = bits.Add64(p[i+1], lo, 0)
+		p[i] = hi + c
+	}
+
+	// Shift smaller numbers down to the same units, and take the fraction.
+	var (
+		f    [4]uint64
+		lost bool // Whether any bits of p were shifted out of f
+	)
+	if exp < 0 {
+		shift := uint(-exp)
+		ws, bs := shift/64, shift%64
+		for i := 3; i >= 0 && uint(i+1) >= ws; i-- {
+			j := uint(i+1) - ws
+			f[i] = p[j] >> bs
+			if j > 0 && bs != 0 {
+				f[i] |= p[j-1] << (64 - bs)
+			}
+		}
+		for i := range p {
+			switch low := uint(64 * (len(p) - 1 - i)); {
+			case low+64 <= shift:
+				lost = lost || p[i] != 0
+			case low < shift:
+				lost = lost || p[i]<<(64-(shift-low)) != 0
+			}
+		}
+	} else {
+		copy(f[:], p[1:])
+	}
+
+	if f == [4]uint64{} && !lost {
+		return 0
+	}
+	if n < 0 {
+		// 1 - f. The complement of a truncated fraction is already truncated.
+		var c uint64
+		if !lost {
+			c = 1
+		}
+		for i := 3; i >= 0; i-- {
+			f[i], c = bits.Add64(^f[i], 0, c)
+		}
+	}
+
+	// r = f * 2π, in units of 2**-509.
+	var r [8]uint64
+	for i := 3; i >= 0; i-- {
+		var c uint64
+		for j := 3; j >= 0; j-- {
+			hi, lo := bits.Mul64(f[i], twoPi[j])
+			var c1, c2 uint64
+			r[i+j+1], c1 = bits.Add64(r[i+j+1], lo, 0)
+			r[i+j+1], c2 = bits.Add64(r[i+j+1], c, 0)
+			c = hi + c1 + c2
+		}
+		r[i] = c
+	}
+
+	// Round the top 64 bits. The rest of the digits of 2π are never all zero, so the result is never exact.
+	i := 0
+	for r[i] == 0 {
+		i++
+	}
+	lz := uint(bits.LeadingZeros64(r[i]))
+	mant := r[i] << lz
+	if i < len(r)-1 {
+		mant |= r[i+1] >> (64 - lz)
+	}
+	rexp := 64*(len(r)-1-i) - int(lz) - 509
+	return floatFromBits[T](roundBits(mant, rexp, true, unsafe.Sizeof(n)))
+}
+
+// CongruentSlice stores src[i] mod 2π in dst[i].
+// dst must be at least as long as src, and may be src itself.
+func (m AngleModulus[T]) CongruentSlice(dst, src []T) {
+	dst = dst[:len(src)]
+	for i, n := range src {
+		dst[i] = m.Congruent(n)
+	}
+}
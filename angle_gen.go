@@ -0,0 +1,83 @@
+//go:build ignore
+
+// This program generates angle_tables.go, the bits of 1/(2π) and 2π used by AngleModulus.
+// Invoke it with go generate.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"math/big"
+	"os"
+)
+
+const (
+	invWords   = 20 // Enough for a 256 bit window starting after the largest float64 exponent
+	twoPiWords = 4
+	guardBits  = 128
+)
+
+func main() {
+	prec := uint(64*invWords + guardBits)
+	one := new(big.Int).Lsh(big.NewInt(1), prec)
+
+	// π = 16 atan(1/5) - 4 atan(1/239), as a fixed point number with prec fraction bits.
+	pi := new(big.Int).Mul(atanInv(5, one), big.NewInt(16))
+	pi.Sub(pi, new(big.Int).Mul(atanInv(239, one), big.NewInt(4)))
+	twoPi := pi.Lsh(pi, 1)
+
+	// 1/(2π) with 64*invWords fraction bits.
+	inv := new(big.Int).Lsh(one, prec)
+	inv.Quo(inv, twoPi)
+	inv.Rsh(inv, guardBits)
+
+	// 2π with 3 integer bits and 64*twoPiWords-3 fraction bits.
+	tp := new(big.Int).Rsh(twoPi, prec-(64*twoPiWords-3))
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by angle_gen.go; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package modular\n\n")
+	fmt.Fprintf(&buf, "// invTwoPi holds the bits of 1/(2π), most significant first, starting from 2**-1.\n")
+	writeWords(&buf, "invTwoPi", inv, invWords)
+	fmt.Fprintf(&buf, "// twoPi holds 2π as a fixed point number with 3 integer bits, most significant first.\n")
+	writeWords(&buf, "twoPi", tp, twoPiWords)
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("angle_tables.go", src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// atanInv returns atan(1/x) as a fixed point number with one as its unit.
+func atanInv(x int64, one *big.Int) *big.Int {
+	sum := new(big.Int)
+	xx := big.NewInt(x * x)
+	power := new(big.Int).Quo(one, big.NewInt(x)) // one / x**(2k+1)
+	term := new(big.Int)
+	for k := int64(0); power.Sign() != 0; k++ {
+		term.Quo(power, big.NewInt(2*k+1))
+		if k%2 == 0 {
+			sum.Add(sum, term)
+		} else {
+			sum.Sub(sum, term)
+		}
+		power.Quo(power, xx)
+	}
+	return sum
+}
+
+// writeWords writes n as an array of n words, most significant first.
+func writeWords(buf *bytes.Buffer, name string, n *big.Int, words int) {
+	mask := new(big.Int).SetUint64(^uint64(0))
+	fmt.Fprintf(buf, "var %v = [%v]uint64{\n", name, words)
+	for i := words - 1; i >= 0; i-- {
+		w := new(big.Int).Rsh(n, uint(64*i))
+		fmt.Fprintf(buf, "0x%016x,\n", w.And(w, mask).Uint64())
+	}
+	fmt.Fprintf(buf, "}\n\n")
+}
@@ -0,0 +1,35 @@
+// Code generated by angle_gen.go; DO NOT EDIT.
+
+package modular
+
+// invTwoPi holds the bits of 1/(2π), most significant first, starting from 2**-1.
+var invTwoPi = [20]uint64{
+	0x28be60db9391054a,
+	0x7f09d5f47d4d3770,
+	0x36d8a5664f10e410,
+	0x7f9458eaf7aef158,
+	0x6dc91b8e909374b8,
+	0x01924bba82746487,
+	0x3f877ac72c4a69cf,
+	0xba208d7d4baed121,
+	0x3a671c09ad17df90,
+	0x4e64758e60d4ce7d,
+	0x272117e2ef7e4a0e,
+	0xc7fe25fff7816603,
+	0xfbcbc462d6829b47,
+	0xdb4d9fb3c9f2c26d,
+	0xd3d18fd9a797fa8b,
+	0x5d49eeb1faf97c5e,
+	0xcf41ce7de294a4ba,
+	0x9afed7ec47e35742,
+	0x1580cc11bf1edaea,
+	0xfc33ef0826bd0d87,
+}
+
+// twoPi holds 2π as a fixed point number with 3 integer bits, most significant first.
+var twoPi = [4]uint64{
+	0xc90fdaa22168c234,
+	0xc4c6628b80dc1cd1,
+	0x29024e088a67cc74,
+	0x020bbea63b139b22,
+}
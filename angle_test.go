@@ -0,0 +1,172 @@
+package modular_test
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/stewi1014/modular"
+)
+
+// bigPrec is enough precision to reduce any float64 by 2π exactly to well beyond float64 precision.
+const bigPrec = 2400
+
+// bigTwoPi is 2π to bigPrec bits, from Machin's formula π = 16 atan(1/5) - 4 atan(1/239).
+var bigTwoPi = func() *big.Float {
+	atanInv := func(x int64) *big.Float {
+		sum := new(big.Float).SetPrec(bigPrec)
+		xx := new(big.Float).SetPrec(bigPrec).SetInt64(x * x)
+		power := new(big.Float).SetPrec(bigPrec).Quo(big.NewFloat(1).SetPrec(bigPrec), new(big.Float).SetInt64(x))
+		term := new(big.Float).SetPrec(bigPrec)
+		eps := new(big.Float).SetMantExp(big.NewFloat(1), -bigPrec-8)
+		for k := int64(0); power.Cmp(eps) > 0; k++ {
+			term.Quo(power, new(big.Float).SetInt64(2*k+1))
+			if k%2 == 0 {
+				sum.Add(sum, term)
+			} else {
+				sum.Sub(sum, term)
+			}
+			power.Quo(power, xx)
+		}
+		return sum
+	}
+
+	pi := new(big.Float).SetPrec(bigPrec).Mul(atanInv(5), big.NewFloat(16))
+	pi.Sub(pi, new(big.Float).SetPrec(bigPrec).Mul(atanInv(239), big.NewFloat(4)))
+	return pi.Mul(pi, big.NewFloat(2))
+}()
+
+// bigAngleMod returns n mod 2π, with the result always satisfying 0 <= r < 2π.
+func bigAngleMod(n float64) *big.Float {
+	r := new(big.Float).SetPrec(bigPrec).SetFloat64(n)
+	k := new(big.Float).SetPrec(bigPrec).Quo(r, bigTwoPi)
+	ki, _ := k.Int(nil)
+	if k.Sign() < 0 && !k.IsInt() {
+		ki.Sub(ki, big.NewInt(1)) // Int truncates, we want the floor
+	}
+	k.SetInt(ki)
+	return r.Sub(r, k.Mul(k, bigTwoPi))
+}
+
+func TestAngleModulus_Congruent(t *testing.T) {
+	tests := []struct {
+		name string
+		arg  float64
+		want float64
+	}{
+		{
+			name: "Small number",
+			arg:  1,
+			want: 1,
+		},
+		{
+			name: "Negative number",
+			arg:  -1,
+			want: 5.283185307179586,
+		},
+		{
+			name: "Rounded modulus",
+			arg:  2 * math.Pi,
+			want: 2 * math.Pi,
+		},
+		{
+			name: "Multiple of rounded modulus",
+			arg:  4 * math.Pi,
+			want: 6.283185307179586,
+		},
+		{
+			name: "Rounds up to modulus",
+			arg:  -0x1p-1074,
+			want: 2 * math.Pi,
+		},
+		{
+			name: "Large number",
+			arg:  1e22,
+			want: 5.263007914620499,
+		},
+		{
+			name: "Infinite number",
+			arg:  math.Inf(-1),
+			want: math.NaN(),
+		},
+		{
+			name: "NaN number",
+			arg:  math.NaN(),
+			want: math.NaN(),
+		},
+	}
+	m := modular.NewAngleModulus[float64]()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := m.Congruent(tt.arg); got != tt.want && !(math.IsNaN(got) && math.IsNaN(tt.want)) {
+				t.Errorf("AngleModulus.Congruent(%v) = %v, want %v", tt.arg, got, tt.want)
+			}
+		})
+	}
+
+	t.Run("Sine of large number", func(t *testing.T) {
+		// math.Sin does its own Payne-Hanek reduction for large arguments.
+		if got, want := math.Sin(m.Congruent(1e22)), -0.8522008497671888; math.Abs(got-want) > 1e-15 {
+			t.Errorf("Sin(AngleModulus.Congruent(1e22)) = %v, want %v", got, want)
+		}
+	})
+}
+
+func TestAngleModulus_CongruentRandom(t *testing.T) {
+	m64, m32 := modular.NewAngleModulus[float64](), modular.NewAngleModulus[float32]()
+
+	// Numbers near multiples of 2π lose the most bits to cancellation.
+	hard := []float64{1e22, 6381956970095103 * 0x1p797, 5261692873635770 * 0x1p499, 0x1.6ac5b262ca1ffp849}
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < randomTestNum/4+len(hard); i++ {
+		var n float64
+		if i < len(hard) {
+			n = hard[i]
+		} else {
+			n = math.Ldexp(r.Float64()*2-1, r.Intn(2100)-1075)
+		}
+		if math.IsInf(n, 0) {
+			continue
+		}
+
+		want64, _ := bigAngleMod(n).Float64()
+		if got := m64.Congruent(n); got != want64 {
+			t.Fatalf("AngleModulus.Congruent(%v) = %v, want %v", n, got, want64)
+		}
+
+		n32 := float32(n)
+		if math.IsInf(float64(n32), 0) {
+			continue
+		}
+		want32, _ := bigAngleMod(float64(n32)).Float32()
+		if got := m32.Congruent(n32); got != want32 {
+			t.Fatalf("AngleModulus.Congruent(%v) = %v, want %v", n32, got, want32)
+		}
+	}
+}
+
+func TestAngleModulus_CongruentSlice(t *testing.T) {
+	m := modular.NewAngleModulus[float32]()
+	src := []float32{0, 1, -1, 1e30, -1e30, float32(math.NaN())}
+	dst := make([]float32, len(src))
+	m.CongruentSlice(dst, src)
+	for i, n := range src {
+		if want := m.Congruent(n); dst[i] != want && !(dst[i] != dst[i] && want != want) {
+			t.Errorf("AngleModulus.CongruentSlice()[%v] = %v, want %v", n, dst[i], want)
+		}
+	}
+}
+
+func BenchmarkAngleModulus(b *testing.B) {
+	m := modular.NewAngleModulus[float64]()
+	for _, n := range []float64{1, -1, 1e22, 1e300} {
+		b.Run(fmt.Sprintf("AngleModulus.Congruent(%v)", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				float64Sink = m.Congruent(n)
+			}
+		})
+	}
+}
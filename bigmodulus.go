@@ -0,0 +1,143 @@
+package modular
+
+import (
+	"math/big"
+)
+
+// NewBigModulus creates a new BigModulus with an exact rational modulus.
+//
+// The modulus is copied, and its sign is ignored.
+// Results given as a big.Float are rounded to nearest even by default, with the precision of the number being reduced.
+//
+// Special cases:
+//		NewBigModulus(0) = panic(integer divide by zero)
+func NewBigModulus(modulus *big.Rat) BigModulus {
+	if modulus.Sign() == 0 {
+		panic("integer divide by zero")
+	}
+	return BigModulus{
+		mod:  new(big.Rat).Abs(modulus),
+		mode: big.ToNearestEven,
+	}
+}
+
+// NewBigModulusFloat creates a new BigModulus with the exact value of a big.Float modulus.
+//
+// Special cases:
+//		NewBigModulusFloat(0) = panic(integer divide by zero)
+//		NewBigModulusFloat(±Inf) = panic(ErrNaN)
+func NewBigModulusFloat(modulus *big.Float) BigModulus {
+	r, _ := modulus.Rat(nil)
+	if r == nil {
+		panic(big.ErrNaN{})
+	}
+	return NewBigModulus(r)
+}
+
+// BigModulus defines a modulus over arbitrary precision numbers.
+//
+// It has the same semantics as Modulus; Congruent is the euclidean modulo, Dist the shortest signed distance and so on.
+// Reduction is always exact, so it can be used to check the results of a Modulus, or to replace them.
+// Results given as a big.Rat are exact, and results given as a big.Float are rounded once, with the BigModulus's precision and rounding mode.
+//
+// A BigModulus is immutable, and safe for concurrent use.
+type BigModulus struct {
+	mod  *big.Rat
+	prec uint
+	mode big.RoundingMode
+}
+
+// WithPrec returns a copy of m that gives big.Float results with the given precision.
+// A precision of 0 uses the precision of the number being reduced.
+func (m BigModulus) WithPrec(prec uint) BigModulus {
+	m.prec = prec
+	return m
+}
+
+// WithMode returns a copy of m that rounds big.Float results with the given rounding mode.
+func (m BigModulus) WithMode(mode big.RoundingMode) BigModulus {
+	m.mode = mode
+	return m
+}
+
+// Mod returns the modulus.
+func (m BigModulus) Mod() *big.Rat {
+	return new(big.Rat).Set(m.mod)
+}
+
+// Congruent returns n mod m.
+func (m BigModulus) Congruent(n *big.Rat) *big.Rat {
+	// n - floor(n/m)*m. big.Int's Div is euclidean, which is the floor for a positive denominator.
+	q := new(big.Rat).Quo(n, m.mod)
+	k := new(big.Int).Div(q.Num(), q.Denom())
+	q.SetInt(k)
+	q.Mul(q, m.mod)
+	return q.Sub(n, q)
+}
+
+// Dist returns the distance and direction of n1 to n2.
+func (m BigModulus) Dist(n1, n2 *big.Rat) *big.Rat {
+	d := m.Congruent(new(big.Rat).Sub(n2, n1))
+	half := new(big.Rat).Quo(m.mod, big.NewRat(2, 1))
+	if d.Cmp(half) > 0 {
+		return d.Sub(d, m.mod)
+	}
+	return d
+}
+
+// GetCongruent returns the closest number to n1 that is congruent to n2.
+func (m BigModulus) GetCongruent(n1, n2 *big.Rat) *big.Rat {
+	d := m.Dist(n2, n1)
+	return d.Sub(n1, d)
+}
+
+// CongruentFloat returns n mod m, rounded with m's precision and rounding mode.
+//
+// Special cases:
+//		BigModulus{m}.CongruentFloat(±Inf) = panic(ErrNaN)
+func (m BigModulus) CongruentFloat(n *big.Float) *big.Float {
+	return m.float(m.Congruent(m.rat(n)), n)
+}
+
+// DistFloat returns the distance and direction of n1 to n2, rounded with m's precision and rounding mode.
+// A precision of 0 uses the larger precision of n1 and n2.
+//
+// Special cases:
+//		BigModulus{m}.DistFloat(±Inf, n2) = panic(ErrNaN)
+//		BigModulus{m}.DistFloat(n1, ±Inf) = panic(ErrNaN)
+func (m BigModulus) DistFloat(n1, n2 *big.Float) *big.Float {
+	return m.float(m.Dist(m.rat(n1), m.rat(n2)), n1, n2)
+}
+
+// GetCongruentFloat returns the closest number to n1 that is congruent to n2, rounded with m's precision and rounding mode.
+// A precision of 0 uses the larger precision of n1 and n2.
+//
+// Special cases:
+//		BigModulus{m}.GetCongruentFloat(±Inf, n2) = panic(ErrNaN)
+//		BigModulus{m}.GetCongruentFloat(n1, ±Inf) = panic(ErrNaN)
+func (m BigModulus) GetCongruentFloat(n1, n2 *big.Float) *big.Float {
+	return m.float(m.GetCongruent(m.rat(n1), m.rat(n2)), n1, n2)
+}
+
+// rat returns the exact value of a finite n.
+func (m BigModulus) rat(n *big.Float) *big.Rat {
+	r, _ := n.Rat(nil)
+	if r == nil {
+		panic(big.ErrNaN{})
+	}
+	return r
+}
+
+// float rounds r with m's precision and rounding mode, or the largest precision of args if m's is 0.
+func (m BigModulus) float(r *big.Rat, args ...*big.Float) *big.Float {
+	prec := m.prec
+	if prec == 0 {
+		for _, a := range args {
+			if a.Prec() > prec {
+				prec = a.Prec()
+			}
+		}
+	}
+	// With a precision of 0, SetRat picks a precision that holds r exactly, up to 64 bits.
+	return new(big.Float).SetPrec(prec).SetMode(m.mode).SetRat(r)
+}
@@ -0,0 +1,207 @@
+package modular_test
+
+import (
+	"math"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/stewi1014/modular"
+)
+
+func TestBigModulus_Congruent(t *testing.T) {
+	tests := []struct {
+		name    string
+		modulus *big.Rat
+		arg     *big.Rat
+		want    *big.Rat
+	}{
+		{
+			name:    "Basic test",
+			modulus: big.NewRat(5, 1),
+			arg:     big.NewRat(13, 1),
+			want:    big.NewRat(3, 1),
+		},
+		{
+			name:    "Negative number",
+			modulus: big.NewRat(5, 1),
+			arg:     big.NewRat(-13, 1),
+			want:    big.NewRat(2, 1),
+		},
+		{
+			name:    "Negative modulus",
+			modulus: big.NewRat(-5, 1),
+			arg:     big.NewRat(-13, 1),
+			want:    big.NewRat(2, 1),
+		},
+		{
+			name:    "Fractional modulus",
+			modulus: big.NewRat(1, 10),
+			arg:     big.NewRat(-7, 20),
+			want:    big.NewRat(1, 20),
+		},
+		{
+			name:    "Multiple of modulus",
+			modulus: big.NewRat(1, 3),
+			arg:     big.NewRat(-1, 1),
+			want:    new(big.Rat),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := modular.NewBigModulus(tt.modulus)
+			if got := m.Congruent(tt.arg); got.Cmp(tt.want) != 0 {
+				t.Errorf("BigModulus{%v}.Congruent(%v) = %v, want %v", tt.modulus, tt.arg, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBigModulus_Dist(t *testing.T) {
+	tests := []struct {
+		name      string
+		n1, n2    *big.Rat
+		want      *big.Rat
+		congruent *big.Rat
+	}{
+		{
+			name:      "Forwards",
+			n1:        big.NewRat(1, 1),
+			n2:        big.NewRat(3, 1),
+			want:      big.NewRat(2, 1),
+			congruent: big.NewRat(3, 1),
+		},
+		{
+			name:      "Backwards across the modulus",
+			n1:        big.NewRat(1, 1),
+			n2:        big.NewRat(9, 1),
+			want:      big.NewRat(-2, 1),
+			congruent: big.NewRat(-1, 1),
+		},
+		{
+			name:      "Half the modulus",
+			n1:        big.NewRat(0, 1),
+			n2:        big.NewRat(5, 1),
+			want:      big.NewRat(5, 1),
+			congruent: big.NewRat(-5, 1),
+		},
+		{
+			name:      "Large numbers",
+			n1:        big.NewRat(1e18+1, 1),
+			n2:        big.NewRat(-1e18+1, 1),
+			want:      new(big.Rat),
+			congruent: big.NewRat(1e18+1, 1),
+		},
+	}
+	m := modular.NewBigModulus(big.NewRat(10, 1))
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := m.Dist(tt.n1, tt.n2); got.Cmp(tt.want) != 0 {
+				t.Errorf("BigModulus{10}.Dist(%v, %v) = %v, want %v", tt.n1, tt.n2, got, tt.want)
+			}
+			if got := m.GetCongruent(tt.n1, tt.n2); got.Cmp(tt.congruent) != 0 {
+				t.Errorf("BigModulus{10}.GetCongruent(%v, %v) = %v, want %v", tt.n1, tt.n2, got, tt.congruent)
+			}
+		})
+	}
+}
+
+func TestBigModulus_CongruentFloat(t *testing.T) {
+	// A float64 n mod m needs no rounding, except for m - r with negative numbers, which Modulus rounds to nearest.
+	// So a BigModulus with float64 precision should always agree with a Modulus.
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < randomTestNum/4; i++ {
+		mod := math.Ldexp(r.Float64()+0.5, r.Intn(1000)-500)
+		n := math.Ldexp(r.Float64()*2-1, r.Intn(1600)-500)
+		if math.IsInf(n, 0) {
+			continue
+		}
+
+		bm := modular.NewBigModulusFloat(big.NewFloat(mod)).WithPrec(53)
+		got, _ := bm.CongruentFloat(big.NewFloat(n)).Float64()
+		if want := modular.NewModulus(mod).Congruent(n); got != want {
+			t.Fatalf("BigModulus{%v}.CongruentFloat(%v) = %v, want %v", mod, n, got, want)
+		}
+	}
+}
+
+func TestBigModulus_Rounding(t *testing.T) {
+	m := modular.NewBigModulus(big.NewRat(1, 3))
+	n := new(big.Float).SetPrec(200).SetInt64(-1)
+	n.Quo(n, big.NewFloat(1024))
+
+	// 1/3 - 1/1024 = 1021/3072
+	want := big.NewRat(1021, 3072)
+	tests := []struct {
+		name string
+		m    modular.BigModulus
+		prec uint
+		mode big.RoundingMode
+	}{
+		{
+			name: "Default",
+			m:    m,
+			prec: 200,
+			mode: big.ToNearestEven,
+		},
+		{
+			name: "Precision",
+			m:    m.WithPrec(10),
+			prec: 10,
+			mode: big.ToNearestEven,
+		},
+		{
+			name: "Mode",
+			m:    m.WithPrec(10).WithMode(big.ToZero),
+			prec: 10,
+			mode: big.ToZero,
+		},
+		{
+			name: "Away from zero",
+			m:    m.WithPrec(10).WithMode(big.AwayFromZero),
+			prec: 10,
+			mode: big.AwayFromZero,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := new(big.Float).SetPrec(tt.prec).SetMode(tt.mode).SetRat(want)
+			got := tt.m.CongruentFloat(n)
+			if got.Cmp(w) != 0 || got.Prec() != tt.prec || got.Mode() != tt.mode {
+				t.Errorf("BigModulus{1/3}.CongruentFloat(%v) = %v (prec %v, %v), want %v (prec %v, %v)",
+					n, got, got.Prec(), got.Mode(), w, tt.prec, tt.mode)
+			}
+		})
+	}
+
+	if got := m.WithPrec(10).DistFloat(big.NewFloat(0), n); got.Prec() != 10 {
+		t.Errorf("BigModulus.DistFloat() precision = %v, want 10", got.Prec())
+	}
+	if got := m.GetCongruentFloat(big.NewFloat(1), n); got.Prec() != 200 {
+		t.Errorf("BigModulus.GetCongruentFloat() precision = %v, want 200", got.Prec())
+	}
+}
+
+func TestNewBigModulus(t *testing.T) {
+	r := big.NewRat(-2, 3)
+	m := modular.NewBigModulus(r)
+	r.SetInt64(5)
+	if got, want := m.Mod(), big.NewRat(2, 3); got.Cmp(want) != 0 {
+		t.Errorf("NewBigModulus(-2/3).Mod() = %v, want %v", got, want)
+	}
+
+	for _, f := range []func(){
+		func() { modular.NewBigModulus(new(big.Rat)) },
+		func() { modular.NewBigModulusFloat(new(big.Float).SetInf(false)) },
+		func() { m.CongruentFloat(new(big.Float).SetInf(true)) },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("expected panic")
+				}
+			}()
+			f()
+		}()
+	}
+}
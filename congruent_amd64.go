@@ -0,0 +1,82 @@
+//go:build amd64 && !purego
+
+package modular
+
+import (
+	"unsafe"
+)
+
+// useAVX2 is set if the CPU and OS support the AVX2 and FMA instructions used by the batch kernels.
+var useAVX2 = hasAVX2()
+
+func hasAVX2() bool {
+	maxID, _, _, _ := cpuid(0, 0)
+	if maxID < 7 {
+		return false
+	}
+
+	const (
+		fma     = 1 << 12
+		osxsave = 1 << 27
+		avx     = 1 << 28
+	)
+	_, _, ecx, _ := cpuid(1, 0)
+	if ecx&(fma|osxsave|avx) != fma|osxsave|avx {
+		return false
+	}
+	if xcr0, _ := xgetbv(); xcr0&6 != 6 { // OS saves the XMM and YMM registers
+		return false
+	}
+
+	const avx2 = 1 << 5
+	_, ebx, _, _ := cpuid(7, 0)
+	return ebx&avx2 != 0
+}
+
+// congruentBatch computes dst[i] = src[i] mod m for as many leading elements as the vector kernels can,
+// returning the number computed.
+// It stops at the first block of elements that it can't handle, and leaves the rest to the caller.
+//
+// The kernels compute q = round(n/m) and r = fma(-q, m, n), adding m if r is negative.
+// For |n| < m * 2**(fracBits-1), the quotient is off by less than 1/4, so |r| < m.
+// r is then a multiple of the ulp of m that is smaller than m, so the fma computes it exactly,
+// and the result is bit for bit the same as Congruent.
+func congruentBatch[T Float](dst, src []T, mod T) int {
+	if !useAVX2 || len(src) < batchLanes || mod-mod != 0 { // Infinite modulus
+		return 0
+	}
+
+	if unsafe.Sizeof(mod) == 4 {
+		m := *(*float32)(unsafe.Pointer(&mod))
+		return congruentF32AVX2(
+			unsafe.Slice((*float32)(unsafe.Pointer(&dst[0])), len(dst)),
+			unsafe.Slice((*float32)(unsafe.Pointer(&src[0])), len(src)),
+			m, m*(1<<(f32FractionBits-1)),
+		)
+	}
+
+	m := *(*float64)(unsafe.Pointer(&mod))
+	return congruentF64AVX2(
+		unsafe.Slice((*float64)(unsafe.Pointer(&dst[0])), len(dst)),
+		unsafe.Slice((*float64)(unsafe.Pointer(&src[0])), len(src)),
+		m, m*(1<<(f64FractionBits-1)),
+	)
+}
+
+// cpuid executes the CPUID instruction.
+func cpuid(eaxArg, ecxArg uint32) (eax, ebx, ecx, edx uint32)
+
+// xgetbv returns XCR0.
+func xgetbv() (eax, edx uint32)
+
+// congruentF64AVX2 computes dst[i] = src[i] mod m in blocks of 4, stopping at the first block with an element not satisfying |n| < lim.
+// It returns the number of elements computed.
+//
+//go:noescape
+func congruentF64AVX2(dst, src []float64, mod, lim float64) int
+
+// congruentF32AVX2 computes dst[i] = src[i] mod m in blocks of 8, stopping at the first block with an element not satisfying |n| < lim.
+// It returns the number of elements computed.
+//
+//go:noescape
+func congruentF32AVX2(dst, src []float32, mod, lim float32) int
@@ -0,0 +1,106 @@
+//go:build amd64 && !purego
+
+#include "textflag.h"
+
+// func cpuid(eaxArg, ecxArg uint32) (eax, ebx, ecx, edx uint32)
+TEXT ·cpuid(SB), NOSPLIT, $0-24
+	MOVL eaxArg+0(FP), AX
+	MOVL ecxArg+4(FP), CX
+	CPUID
+	MOVL AX, eax+8(FP)
+	MOVL BX, ebx+12(FP)
+	MOVL CX, ecx+16(FP)
+	MOVL DX, edx+20(FP)
+	RET
+
+// func xgetbv() (eax, edx uint32)
+TEXT ·xgetbv(SB), NOSPLIT, $0-8
+	MOVL $0, CX
+	XGETBV
+	MOVL AX, eax+0(FP)
+	MOVL DX, edx+4(FP)
+	RET
+
+// func congruentF64AVX2(dst, src []float64, mod, lim float64) int
+TEXT ·congruentF64AVX2(SB), NOSPLIT, $0-72
+	MOVQ         dst_base+0(FP), DI
+	MOVQ         src_base+24(FP), SI
+	MOVQ         src_len+32(FP), CX
+	VBROADCASTSD mod+48(FP), Y10
+	VBROADCASTSD lim+56(FP), Y11
+	VPCMPEQQ     Y12, Y12, Y12
+	VPSRLQ       $1, Y12, Y12         // Y12 = abs mask
+	VXORPD       Y13, Y13, Y13        // Y13 = 0
+	XORQ         AX, AX
+	SUBQ         $4, CX
+
+loop64:
+	CMPQ      AX, CX
+	JGT       done64
+	VMOVUPD   (SI)(AX*8), Y0          // n
+	VANDPD    Y12, Y0, Y1             // |n|
+	VCMPPD    $1, Y11, Y1, Y2         // |n| < lim, false for NaN
+	VMOVMSKPD Y2, DX
+	CMPQ      DX, $0x0f
+	JNE       done64
+
+	VDIVPD       Y10, Y0, Y3          // n / m
+	VROUNDPD     $0, Y3, Y3           // q = round(n / m)
+	VMOVAPD      Y0, Y4
+	VFNMADD231PD Y10, Y3, Y4          // r = n - q*m, exactly
+	VCMPPD       $1, Y13, Y4, Y5      // r < 0
+	VANDPD       Y10, Y5, Y5
+	VADDPD       Y5, Y4, Y4           // r += m for negative r
+	VCMPPD       $0, Y13, Y0, Y6      // n == 0
+	VBLENDVPD    Y6, Y0, Y4, Y4       // keep the sign of zero
+	VMOVUPD      Y4, (DI)(AX*8)
+
+	ADDQ $4, AX
+	JMP  loop64
+
+done64:
+	VZEROUPPER
+	MOVQ AX, ret+64(FP)
+	RET
+
+// func congruentF32AVX2(dst, src []float32, mod, lim float32) int
+TEXT ·congruentF32AVX2(SB), NOSPLIT, $0-64
+	MOVQ         dst_base+0(FP), DI
+	MOVQ         src_base+24(FP), SI
+	MOVQ         src_len+32(FP), CX
+	VBROADCASTSS mod+48(FP), Y10
+	VBROADCASTSS lim+52(FP), Y11
+	VPCMPEQD     Y12, Y12, Y12
+	VPSRLD       $1, Y12, Y12         // Y12 = abs mask
+	VXORPS       Y13, Y13, Y13        // Y13 = 0
+	XORQ         AX, AX
+	SUBQ         $8, CX
+
+loop32:
+	CMPQ      AX, CX
+	JGT       done32
+	VMOVUPS   (SI)(AX*4), Y0          // n
+	VANDPS    Y12, Y0, Y1             // |n|
+	VCMPPS    $1, Y11, Y1, Y2         // |n| < lim, false for NaN
+	VMOVMSKPS Y2, DX
+	CMPQ      DX, $0xff
+	JNE       done32
+
+	VDIVPS       Y10, Y0, Y3          // n / m
+	VROUNDPS     $0, Y3, Y3           // q = round(n / m)
+	VMOVAPS      Y0, Y4
+	VFNMADD231PS Y10, Y3, Y4          // r = n - q*m, exactly
+	VCMPPS       $1, Y13, Y4, Y5      // r < 0
+	VANDPS       Y10, Y5, Y5
+	VADDPS       Y5, Y4, Y4           // r += m for negative r
+	VCMPPS       $0, Y13, Y0, Y6      // n == 0
+	VBLENDVPS    Y6, Y0, Y4, Y4       // keep the sign of zero
+	VMOVUPS      Y4, (DI)(AX*4)
+
+	ADDQ $8, AX
+	JMP  loop32
+
+done32:
+	VZEROUPPER
+	MOVQ AX, ret+56(FP)
+	RET
@@ -0,0 +1,9 @@
+//go:build !amd64 || purego
+
+package modular
+
+// congruentBatch computes dst[i] = src[i] mod m for as many leading elements as the vector kernels can.
+// There are no vector kernels for this architecture, so it never computes any.
+func congruentBatch[T Float](dst, src []T, mod T) int {
+	return 0
+}
@@ -0,0 +1,77 @@
+package modular
+
+import (
+	"math/bits"
+)
+
+// Combine returns the modulus of the combined cycle of m1 and m2; their least common multiple.
+//
+// Special cases:
+//		Combine(m1, m2) = ErrOverflow if the least common multiple doesn't fit in T
+func Combine[T Integer](m1, m2 IntModulus[T]) (IntModulus[T], error) {
+	l, _, ok := lcm[T](m1.mod, m2.mod)
+	if !ok {
+		return IntModulus[T]{}, ErrOverflow
+	}
+	return NewIntModulus(T(l)), nil
+}
+
+// CRT solves the system of congruences n = residues[i] (mod moduli[i]) using the Chinese Remainder Theorem.
+// It returns the smallest non-negative solution, and the modulus that all solutions are congruent under;
+// the least common multiple of the moduli.
+// The moduli need not be coprime.
+//
+// CRT panics if residues and moduli have different lengths.
+//
+// Special cases:
+//		CRT([], []) = 0, IntModulus{1}
+//		CRT(r, m) = ErrNoSolution if the congruences contradict each other
+//		CRT(r, m) = ErrOverflow if the least common multiple of the moduli doesn't fit in T
+func CRT[T Integer](residues []T, moduli []IntModulus[T]) (T, IntModulus[T], error) {
+	if len(residues) != len(moduli) {
+		panic("modular: CRT called with different numbers of residues and moduli")
+	}
+
+	r, mod := uint64(0), uint64(1)
+	for i, m := range moduli {
+		l, g, ok := lcm[T](mod, m.mod)
+		if !ok {
+			return 0, IntModulus[T]{}, ErrOverflow
+		}
+
+		// n = r + mod*k, where mod*k = residue - r (mod m).
+		// Dividing through by g, k = (residue - r)/g * (mod/g)**-1 (mod m/g).
+		d := m.sub(m.congruent(residues[i]), m.fd.Mod(r))
+		if d%g != 0 {
+			return 0, IntModulus[T]{}, ErrNoSolution
+		}
+		if step := m.mod / g; step > 1 {
+			s := NewIntModulus(step)
+			inv, _ := s.inverse(s.fd.Mod(mod / g)) // mod/g and m/g are coprime
+
+			// r < mod and k < m/g, so r + mod*k < lcm never overflows.
+			r += mod * s.mul(d/g, inv)
+		}
+		mod = l
+	}
+	return T(r), NewIntModulus(T(mod)), nil
+}
+
+// lcm returns the least common multiple and greatest common divisor of a and b,
+// and false if the least common multiple doesn't fit in T.
+func lcm[T Integer](a, b uint64) (uint64, uint64, bool) {
+	g := gcd(a, b)
+	hi, l := bits.Mul64(a/g, b)
+	if hi != 0 || (^T(0) < 0 && l > 1<<63) {
+		return 0, 0, false
+	}
+	return l, g, true
+}
+
+// gcd returns the greatest common divisor of a and b.
+func gcd(a, b uint64) uint64 {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
@@ -0,0 +1,158 @@
+package modular_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stewi1014/modular"
+)
+
+func TestCRT(t *testing.T) {
+	tests := []struct {
+		name      string
+		residues  []int64
+		moduli    []int64
+		want      int64
+		wantMod   int64
+		wantError error
+	}{
+		{
+			name:     "Empty",
+			residues: []int64{},
+			moduli:   []int64{},
+			want:     0,
+			wantMod:  1,
+		},
+		{
+			name:     "Coprime",
+			residues: []int64{2, 3, 2},
+			moduli:   []int64{3, 5, 7},
+			want:     23,
+			wantMod:  105,
+		},
+		{
+			name:     "Negative residues",
+			residues: []int64{-1, -1},
+			moduli:   []int64{4, 6},
+			want:     11,
+			wantMod:  12,
+		},
+		{
+			name:     "Week and fortnight",
+			residues: []int64{3, 10},
+			moduli:   []int64{7, 14},
+			want:     10,
+			wantMod:  14,
+		},
+		{
+			name:      "Inconsistent",
+			residues:  []int64{1, 2},
+			moduli:    []int64{4, 6},
+			wantError: modular.ErrNoSolution,
+		},
+		{
+			name:      "Overflow",
+			residues:  []int64{0, 0},
+			moduli:    []int64{math.MaxInt64, math.MaxInt64 - 1},
+			wantError: modular.ErrOverflow,
+		},
+		{
+			name:     "Smallest modulus",
+			residues: []int64{5, 5},
+			moduli:   []int64{1 << 40, math.MinInt64},
+			want:     5,
+			wantMod:  math.MinInt64,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			moduli := make([]modular.IntModulus[int64], len(tt.moduli))
+			for i, m := range tt.moduli {
+				moduli[i] = modular.NewIntModulus(m)
+			}
+			got, gotMod, err := modular.CRT(tt.residues, moduli)
+			if err != tt.wantError {
+				t.Fatalf("CRT(%v, %v) error = %v, want %v", tt.residues, tt.moduli, err, tt.wantError)
+			}
+			if err == nil && (got != tt.want || gotMod.Mod() != tt.wantMod) {
+				t.Errorf("CRT(%v, %v) = %v, %v, want %v, %v", tt.residues, tt.moduli, got, gotMod.Mod(), tt.want, tt.wantMod)
+			}
+		})
+	}
+}
+
+func TestCRT_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < randomTestNum/10; i++ {
+		n := 1 + r.Intn(3)
+		residues, moduli := make([]uint64, n), make([]modular.IntModulus[uint64], n)
+		lcm := uint64(1)
+		for j := range moduli {
+			m := 1 + r.Uint64()%30
+			residues[j], moduli[j] = r.Uint64(), modular.NewIntModulus(m)
+			l, _ := modular.Combine(modular.NewIntModulus(lcm), moduli[j])
+			lcm = l.Mod()
+		}
+
+		// Brute force the smallest solution.
+		want, ok := uint64(0), false
+		for ; want < lcm && !ok; want++ {
+			ok = true
+			for j, m := range moduli {
+				ok = ok && m.Congruent(want) == m.Congruent(residues[j])
+			}
+		}
+		want--
+
+		got, gotMod, err := modular.CRT(residues, moduli)
+		switch {
+		case !ok && err != modular.ErrNoSolution:
+			t.Fatalf("CRT(%v, ...) = %v, %v, want %v", residues, got, err, modular.ErrNoSolution)
+		case ok && (err != nil || got != want || gotMod.Mod() != lcm):
+			t.Fatalf("CRT(%v, ...) = %v, %v, %v, want %v, %v", residues, got, gotMod.Mod(), err, want, lcm)
+		}
+	}
+}
+
+func TestCombine(t *testing.T) {
+	tests := []struct {
+		name   string
+		m1, m2 uint64
+		want   uint64
+		err    error
+	}{
+		{
+			name: "Coprime",
+			m1:   7,
+			m2:   24,
+			want: 168,
+		},
+		{
+			name: "Common factor",
+			m1:   12,
+			m2:   18,
+			want: 36,
+		},
+		{
+			name: "Largest",
+			m1:   math.MaxUint64,
+			m2:   3,
+			want: math.MaxUint64,
+		},
+		{
+			name: "Overflow",
+			m1:   math.MaxUint64,
+			m2:   2,
+			err:  modular.ErrOverflow,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := modular.Combine(modular.NewIntModulus(tt.m1), modular.NewIntModulus(tt.m2))
+			if err != tt.err || (err == nil && got.Mod() != tt.want) {
+				t.Errorf("Combine(%v, %v) = %v, %v, want %v, %v", tt.m1, tt.m2, got.Mod(), err, tt.want, tt.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package modular
+
+import (
+	"unsafe"
+)
+
+// DoubleDouble is an unevaluated sum of two float64s, Hi + Lo, giving about 106 bits of precision.
+// A normalised DoubleDouble has |Lo| <= ulp(Hi)/2.
+type DoubleDouble struct {
+	Hi, Lo float64
+}
+
+// twoSum returns s = fl(a + b) and the rounding error e, such that s + e = a + b exactly.
+func twoSum[T Float](a, b T) (s, e T) {
+	s = a + b
+	bb := s - a
+	e = (a - (s - bb)) + (b - bb)
+	return s, e
+}
+
+// quickTwoSum is twoSum for |a| >= |b|.
+func quickTwoSum(a, b float64) (s, e float64) {
+	s = a + b
+	e = b - (s - a)
+	return s, e
+}
+
+// addFloat returns d + b.
+func (d DoubleDouble) addFloat(b float64) DoubleDouble {
+	s, e := twoSum(d.Hi, b)
+	s, e = quickTwoSum(s, e+d.Lo)
+	return DoubleDouble{Hi: s, Lo: e}
+}
+
+// floatParts returns f and e such that |x| = f * 2**e, for finite x.
+func floatParts[T Float](x T) (uint64, int) {
+	size := unsafe.Sizeof(x)
+	fr, exp := frexp(x)
+	if exp == 0 {
+		exp = 1 // Denormalised numbers share the smallest exponent.
+	}
+	return fr, int(exp) - int(maxExp(size)/2) - int(fractionBits(size))
+}
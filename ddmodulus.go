@@ -0,0 +1,325 @@
+package modular
+
+import (
+	"math"
+	"math/bits"
+)
+
+// ddPowers is the number of powers of 2**64 a DDModulus keeps;
+// enough to reduce the largest float64 by the smallest modulus.
+const ddPowers = 34
+
+// NewDDModulus creates a new DDModulus.
+//
+// The modulus is normalised, and its sign is ignored.
+// A double-double can hold numbers that need far more than 128 bits, such as 1 + 2**-200;
+// such moduli are rounded to 128 bits, which is still well beyond the precision of a double-double.
+// An Infinite or NaN modulus gives NaN for every number.
+//
+// Special cases:
+//		NewDDModulus(0) = panic(integer divide by zero)
+func NewDDModulus(modulus DoubleDouble) DDModulus {
+	hi, lo := twoSum(modulus.Hi, modulus.Lo)
+	if hi < 0 {
+		hi, lo = -hi, -lo
+	}
+	if hi == 0 {
+		panic("integer divide by zero")
+	}
+
+	m := DDModulus{
+		mod: DoubleDouble{Hi: hi, Lo: lo},
+	}
+	if hi != hi || math.IsInf(hi, 0) {
+		return m
+	}
+
+	// Assemble the exact modulus in units of 2**(eh-138), leaving room for the low part below it.
+	// Any bits of the low part beyond that are folded into the lowest bit, which is enough to round correctly.
+	fh, eh := floatParts(hi)
+	fl, el := floatParts(lo)
+	x := [3]uint64{fh << 10, 0, 0}
+	y, lost := place192(fl, el-eh+138)
+	if lo < 0 {
+		x, _ = sub192(x, y)
+		if lost {
+			x, _ = sub192(x, [3]uint64{0, 0, 1})
+		}
+	} else {
+		x, _ = add192(x, y)
+	}
+	if lost {
+		x[2] |= 1
+	}
+
+	// Normalise, and round to 128 bits.
+	z := leadingZeros192(x)
+	x = shl192(x, z)
+	m.m, m.exp = [2]uint64{x[0], x[1]}, eh-138-int(z)+64
+	if half := uint64(1) << 63; x[2] > half || x[2] == half && m.m[1]&1 != 0 {
+		var c uint64
+		m.m[1], c = bits.Add64(m.m[1], 1, 0)
+		m.m[0], c = bits.Add64(m.m[0], 0, c)
+		if c != 0 {
+			m.m[0] = half
+			m.exp++
+		}
+	}
+
+	// 2**(64i) mod m, for 2**(64i) up to the largest float64 in units of the modulus.
+	m.powers = make([][2]uint64, ddPowers)
+	m.powers[0] = [2]uint64{0, 1}
+	for i := 1; i < ddPowers; i++ {
+		p := m.powers[i-1]
+		r1, r0 := m.mod3by2(p[0], p[1], 0)
+		m.powers[i] = [2]uint64{r1, r0}
+	}
+
+	return m
+}
+
+// DDModulus defines a modulus over double-double numbers.
+//
+// It reduces the high and low parts of a number separately, using the same pre-computed powers of two as Modulus;
+// as its modulus has up to 128 bits, the powers are 128 bit numbers and reduction needs 256 bit by 128 bit division.
+// The reduction is exact, so there's no drift however large the numbers get;
+// the result is only rounded when it is converted back to a double-double.
+type DDModulus struct {
+	m      [2]uint64   // The modulus's fraction, normalised to have its top bit set; most significant first.
+	exp    int         // The modulus is m * 2**exp.
+	powers [][2]uint64 // 2**(64i) mod m
+	mod    DoubleDouble
+}
+
+// Mod returns the modulus.
+func (m DDModulus) Mod() DoubleDouble {
+	return m.mod
+}
+
+// Congruent returns n mod m, which should be normalised.
+//
+// The result is normalised, and accurate to double-double precision.
+//
+// Special cases:
+//		DDModulus{NaN}.Congruent(n) = NaN
+//		DDModulus{±Inf}.Congruent(n) = NaN
+//		DDModulus{m}.Congruent(±Inf) = NaN
+//		DDModulus{m}.Congruent(NaN) = NaN
+func (m DDModulus) Congruent(n DoubleDouble) DoubleDouble {
+	if m.m[0] == 0 || n.Hi != n.Hi || n.Lo != n.Lo || math.IsInf(n.Hi, 0) || math.IsInf(n.Lo, 0) {
+		return DoubleDouble{Hi: math.NaN(), Lo: math.NaN()}
+	}
+
+	if (n.Hi > 0 || n.Hi == 0 && n.Lo >= 0) && (n.Hi < m.mod.Hi || n.Hi == m.mod.Hi && n.Lo < m.mod.Lo) {
+		return n
+	}
+
+	// With the units u = 2**(m.exp-64), each part reduces to a*u + t where t is the exact part of a small number below u.
+	mw := [3]uint64{m.m[0], m.m[1], 0}
+	a, t := m.reduce(n.Hi)
+	b, tb := m.reduce(n.Lo)
+
+	a, c := add192(a, b)
+	if c != 0 || !less192(a, mw) {
+		a, _ = sub192(a, mw)
+	}
+
+	// The sum of the two tails is rounded, but only at 2**-117 of the modulus.
+	if t += tb; t != 0 {
+		u := math.Ldexp(1, m.exp-64)
+		switch {
+		case t >= u:
+			a, _ = add192(a, [3]uint64{0, 0, 1})
+			t -= u
+			if a == mw {
+				a = [3]uint64{}
+			}
+		case t <= -u:
+			if a == [3]uint64{} {
+				a = mw
+			}
+			a, _ = sub192(a, [3]uint64{0, 0, 1})
+			t += u
+		}
+		if t < 0 && a == [3]uint64{} {
+			a = mw
+		}
+	}
+
+	// Sum the result from the smallest part up.
+	r := DoubleDouble{Hi: t}
+	for i := len(a) - 1; i >= 0; i-- {
+		exp := 64*(len(a)-1-i) + m.exp - 64
+		r = r.addFloat(math.Ldexp(float64(a[i]&(1<<32-1)), exp))
+		r = r.addFloat(math.Ldexp(float64(a[i]>>32), exp+32))
+	}
+	return r
+}
+
+// CongruentSlice stores src[i] mod m in dst[i].
+// dst must be at least as long as src, and may be src itself.
+func (m DDModulus) CongruentSlice(dst, src []DoubleDouble) {
+	dst = dst[:len(src)]
+	for i, n := range src {
+		dst[i] = m.Congruent(n)
+	}
+}
+
+// reduce returns x mod m as a*2**(m.exp-64) + t, where a is a 192 bit number and |t| < 2**(m.exp-64).
+// a may equal the modulus when t is negative.
+func (m DDModulus) reduce(x float64) (a [3]uint64, t float64) {
+	if x == 0 {
+		return a, 0
+	}
+
+	mw := [3]uint64{m.m[0], m.m[1], 0}
+	f, e := floatParts(x)
+	if s := e - m.exp + 64; s+bits.Len64(f) <= 192 {
+		w, lost := place192(f, s)
+		if less192(w, mw) {
+			// |x| < m, so only its bits below the units need separating.
+			if lost {
+				tf := f
+				if s > -64 {
+					tf &= 1<<uint(-s) - 1
+				}
+				t = math.Ldexp(float64(tf), e)
+			}
+			if x < 0 {
+				w, _ = sub192(mw, w)
+				t = -t
+			}
+			return w, t
+		}
+	}
+
+	// |x| >= m, so x has no bits below the modulus's and k >= 0.
+	k := e - m.exp
+	f1, f0 := shl128(0, f, uint(k%64))
+	p := m.powers[k/64]
+	r1, r0 := m.mod4by2(mul128(f1, f0, p[0], p[1]))
+	a = [3]uint64{r1, r0, 0}
+	if x < 0 && r1|r0 != 0 {
+		a, _ = sub192(mw, a)
+	}
+	return a, 0
+}
+
+// mod4by2 returns u mod m for a 256 bit u, most significant first.
+func (m DDModulus) mod4by2(u [4]uint64) (uint64, uint64) {
+	r1, r0 := u[0], u[1]
+	if r1 > m.m[0] || r1 == m.m[0] && r0 >= m.m[1] {
+		var b uint64
+		r0, b = bits.Sub64(r0, m.m[1], 0)
+		r1, _ = bits.Sub64(r1, m.m[0], b)
+	}
+	r1, r0 = m.mod3by2(r1, r0, u[2])
+	return m.mod3by2(r1, r0, u[3])
+}
+
+// mod3by2 returns u2, u1, u0 mod m for u2, u1 < m.
+// The quotient is estimated from the top words, which overestimates it by at most 2 as m is normalised.
+func (m DDModulus) mod3by2(u2, u1, u0 uint64) (uint64, uint64) {
+	d1, d0 := m.m[0], m.m[1]
+	q := ^uint64(0)
+	if u2 < d1 {
+		q, _ = bits.Div64(u2, u1, d1)
+	}
+
+	// u - q*d
+	ph, pl := bits.Mul64(q, d0)
+	qh, ql := bits.Mul64(q, d1)
+	p1, c := bits.Add64(ql, ph, 0)
+	p2 := qh + c
+	r0, b := bits.Sub64(u0, pl, 0)
+	r1, b := bits.Sub64(u1, p1, b)
+	r2, _ := bits.Sub64(u2, p2, b)
+
+	// Add d back until the remainder is positive again.
+	for int64(r2) < 0 {
+		r0, c = bits.Add64(r0, d0, 0)
+		r1, c = bits.Add64(r1, d1, c)
+		r2 += c
+	}
+	return r1, r0
+}
+
+// mul128 returns the 256 bit product of a1, a0 and b1, b0, most significant first.
+func mul128(a1, a0, b1, b0 uint64) [4]uint64 {
+	h00, l00 := bits.Mul64(a0, b0)
+	h01, l01 := bits.Mul64(a0, b1)
+	h10, l10 := bits.Mul64(a1, b0)
+	h11, l11 := bits.Mul64(a1, b1)
+
+	t, c1 := bits.Add64(h00, l01, 0)
+	w2, c2 := bits.Add64(t, l10, 0)
+	t, c3 := bits.Add64(h01, h10, c1)
+	w1, c4 := bits.Add64(t, l11, c2)
+	return [4]uint64{h11 + c3 + c4, w1, w2, l00}
+}
+
+// place192 returns x * 2**s as a 192 bit number, most significant first, and whether any bits were shifted out below it.
+// x * 2**s must be less than 2**192.
+func place192(x uint64, s int) (w [3]uint64, lost bool) {
+	switch {
+	case s >= 0:
+		word, bit := s/64, uint(s%64)
+		w[2-word] = x << bit
+		if word < 2 && bit != 0 {
+			w[1-word] = x >> (64 - bit)
+		}
+	case s > -64:
+		w[2] = x >> uint(-s)
+		lost = x<<uint(64+s) != 0
+	default:
+		lost = x != 0
+	}
+	return w, lost
+}
+
+// shl192 returns x << s for s < 192.
+func shl192(x [3]uint64, s uint) [3]uint64 {
+	for ; s >= 64; s -= 64 {
+		x = [3]uint64{x[1], x[2], 0}
+	}
+	if s == 0 {
+		return x
+	}
+	return [3]uint64{x[0]<<s | x[1]>>(64-s), x[1]<<s | x[2]>>(64-s), x[2] << s}
+}
+
+// leadingZeros192 returns the number of leading zero bits in x.
+func leadingZeros192(x [3]uint64) uint {
+	switch {
+	case x[0] != 0:
+		return uint(bits.LeadingZeros64(x[0]))
+	case x[1] != 0:
+		return 64 + uint(bits.LeadingZeros64(x[1]))
+	default:
+		return 128 + uint(bits.LeadingZeros64(x[2]))
+	}
+}
+
+// add192 returns x + y and the carry out.
+func add192(x, y [3]uint64) ([3]uint64, uint64) {
+	var c uint64
+	x[2], c = bits.Add64(x[2], y[2], 0)
+	x[1], c = bits.Add64(x[1], y[1], c)
+	x[0], c = bits.Add64(x[0], y[0], c)
+	return x, c
+}
+
+// sub192 returns x - y and the borrow out.
+func sub192(x, y [3]uint64) ([3]uint64, uint64) {
+	var b uint64
+	x[2], b = bits.Sub64(x[2], y[2], 0)
+	x[1], b = bits.Sub64(x[1], y[1], b)
+	x[0], b = bits.Sub64(x[0], y[0], b)
+	return x, b
+}
+
+// less192 reports whether x < y.
+func less192(x, y [3]uint64) bool {
+	_, b := sub192(x, y)
+	return b != 0
+}
@@ -0,0 +1,187 @@
+package modular_test
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/stewi1014/modular"
+)
+
+var ddSink modular.DoubleDouble
+
+// bigDD returns the exact value of d.
+func bigDD(d modular.DoubleDouble) *big.Float {
+	f := new(big.Float).SetPrec(bigPrec).SetFloat64(d.Hi)
+	return f.Add(f, new(big.Float).SetFloat64(d.Lo))
+}
+
+// bigFloatMod returns n mod m, with the result always satisfying 0 <= r < m for positive m.
+func bigFloatMod(n, m *big.Float) *big.Float {
+	k := new(big.Float).SetPrec(bigPrec).Quo(n, m)
+	ki, _ := k.Int(nil)
+	if k.Sign() < 0 && !k.IsInt() {
+		ki.Sub(ki, big.NewInt(1))
+	}
+	k.SetInt(ki)
+	k.Mul(k, m)
+	return k.Sub(n, k)
+}
+
+// checkDD checks that got is a normalised double-double within tol of want.
+func checkDD(t *testing.T, m, n, got modular.DoubleDouble, want *big.Float, tol float64) {
+	t.Helper()
+	if got.Hi+got.Lo != got.Hi {
+		t.Fatalf("DDModulus{%v}.Congruent(%v) = %v, which isn't normalised", m, n, got)
+	}
+	diff := new(big.Float).Sub(bigDD(got), want)
+	if d, _ := diff.Float64(); math.Abs(d) > tol {
+		w, _ := want.Float64()
+		t.Fatalf("DDModulus{%v}.Congruent(%v) = %v, want %v (%v off)", m, n, got, w, d)
+	}
+}
+
+func TestDDModulus_Congruent(t *testing.T) {
+	twoPi := modular.DoubleDouble{Hi: 6.283185307179586, Lo: 2.4492935982947064e-16}
+	tests := []struct {
+		name    string
+		modulus modular.DoubleDouble
+		arg     modular.DoubleDouble
+		want    modular.DoubleDouble
+	}{
+		{
+			name:    "Basic test",
+			modulus: modular.DoubleDouble{Hi: 10},
+			arg:     modular.DoubleDouble{Hi: 25},
+			want:    modular.DoubleDouble{Hi: 5},
+		},
+		{
+			name:    "Negative number",
+			modulus: modular.DoubleDouble{Hi: 10},
+			arg:     modular.DoubleDouble{Hi: -3},
+			want:    modular.DoubleDouble{Hi: 7},
+		},
+		{
+			name:    "Negative modulus",
+			modulus: modular.DoubleDouble{Hi: -10},
+			arg:     modular.DoubleDouble{Hi: 25},
+			want:    modular.DoubleDouble{Hi: 5},
+		},
+		{
+			name:    "Tiny low part",
+			modulus: modular.DoubleDouble{Hi: 1},
+			arg:     modular.DoubleDouble{Hi: 3, Lo: 1e-40},
+			want:    modular.DoubleDouble{Hi: 1e-40},
+		},
+		{
+			name:    "Tiny negative number",
+			modulus: modular.DoubleDouble{Hi: 1},
+			arg:     modular.DoubleDouble{Hi: -1e-300},
+			want:    modular.DoubleDouble{Hi: 1, Lo: -1e-300},
+		},
+		{
+			name:    "Low part of modulus",
+			modulus: twoPi,
+			arg:     modular.DoubleDouble{Hi: 2 * twoPi.Hi, Lo: 2 * twoPi.Lo},
+			want:    modular.DoubleDouble{},
+		},
+		{
+			name:    "Small number",
+			modulus: twoPi,
+			arg:     modular.DoubleDouble{Hi: 1, Lo: 1e-20},
+			want:    modular.DoubleDouble{Hi: 1, Lo: 1e-20},
+		},
+		{
+			name:    "Infinite number",
+			modulus: twoPi,
+			arg:     modular.DoubleDouble{Hi: math.Inf(1)},
+			want:    modular.DoubleDouble{Hi: math.NaN(), Lo: math.NaN()},
+		},
+		{
+			name:    "NaN modulus",
+			modulus: modular.DoubleDouble{Hi: math.NaN()},
+			arg:     modular.DoubleDouble{Hi: 1},
+			want:    modular.DoubleDouble{Hi: math.NaN(), Lo: math.NaN()},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := modular.NewDDModulus(tt.modulus)
+			got := m.Congruent(tt.arg)
+			if got != tt.want && !(math.IsNaN(got.Hi) && math.IsNaN(tt.want.Hi)) {
+				t.Errorf("DDModulus{%v}.Congruent(%v) = %v, want %v", tt.modulus, tt.arg, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDDModulus_CongruentRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	randDD := func(minExp, maxExp int) modular.DoubleDouble {
+		hi := math.Ldexp(r.Float64()*2-1, r.Intn(maxExp-minExp)+minExp)
+		return modular.DoubleDouble{Hi: hi, Lo: hi * (r.Float64() - 0.5) * 0x1p-53}
+	}
+
+	for i := 0; i < randomTestNum/4; i++ {
+		mod := randDD(-1000, 1000)
+		n := randDD(-1070, 1020)
+		if math.IsInf(n.Hi, 0) || mod.Hi == 0 {
+			continue
+		}
+
+		m := modular.NewDDModulus(mod)
+		bm := new(big.Float).Abs(bigDD(mod))
+		want := bigFloatMod(bigDD(n), bm)
+
+		// The result is rounded to double-double precision, and the sum of the parts below the modulus to 2**-117 of it.
+		w, _ := want.Float64()
+		tol := math.Abs(w)*0x1p-104 + math.Abs(mod.Hi)*0x1p-112
+		checkDD(t, mod, n, m.Congruent(n), want, tol)
+	}
+}
+
+func TestNewDDModulus(t *testing.T) {
+	m := modular.NewDDModulus(modular.DoubleDouble{Hi: 0x1p-54, Lo: 1})
+	if got, want := m.Mod(), (modular.DoubleDouble{Hi: 1, Lo: 0x1p-54}); got != want {
+		t.Errorf("NewDDModulus(2**-54, 1).Mod() = %v, want %v", got, want)
+	}
+
+	// Moduli needing more than 128 bits are rounded.
+	m = modular.NewDDModulus(modular.DoubleDouble{Hi: 1, Lo: 0x1p-200})
+	n := modular.DoubleDouble{Hi: 0x1p150}
+	want := new(big.Float).SetPrec(bigPrec).SetFloat64(0x1p150)
+	want = bigFloatMod(want, new(big.Float).SetFloat64(1))
+	checkDD(t, m.Mod(), n, m.Congruent(n), want, 0)
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("NewDDModulus(0) didn't panic")
+		}
+	}()
+	modular.NewDDModulus(modular.DoubleDouble{})
+}
+
+func TestDDModulus_CongruentSlice(t *testing.T) {
+	m := modular.NewDDModulus(modular.DoubleDouble{Hi: 0.1, Lo: -5.551115123125783e-18})
+	src := []modular.DoubleDouble{{}, {Hi: 1}, {Hi: -0.35}, {Hi: 1e16}, {Hi: 1e300, Lo: 1e280}, {Hi: math.NaN()}}
+	dst := make([]modular.DoubleDouble, len(src))
+	m.CongruentSlice(dst, src)
+	for i, n := range src {
+		if want := m.Congruent(n); dst[i] != want && !(math.IsNaN(dst[i].Hi) && math.IsNaN(want.Hi)) {
+			t.Errorf("DDModulus.CongruentSlice()[%v] = %v, want %v", n, dst[i], want)
+		}
+	}
+}
+
+func BenchmarkDDModulus(b *testing.B) {
+	m := modular.NewDDModulus(modular.DoubleDouble{Hi: 6.283185307179586, Lo: 2.4492935982947064e-16})
+	for _, n := range []modular.DoubleDouble{{Hi: 1}, {Hi: -1}, {Hi: 1e22, Lo: 1}, {Hi: 1e300}} {
+		b.Run(fmt.Sprintf("DDModulus.Congruent(%v)", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				ddSink = m.Congruent(n)
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package modular
+
+import (
+	"math"
+	"math/big"
+	"math/bits"
+)
+
+// Divmod returns n mod m and the number of whole moduli removed from n, floor(n / m).
+//
+// r is the same as Congruent(n), and the quotient is exact, so n = q*m + r before r is rounded.
+// Like Congruent, AddMod and MulMod, a remainder just below m can round to m;
+// only a negative number smaller than m can do that, giving m and -1.
+// If the quotient doesn't fit in an int64, q saturates at math.MaxInt64 or math.MinInt64 and overflow is true;
+// DivmodBig gives the exact quotient.
+//
+// Special cases:
+//		Modulus{NaN}.Divmod(n) = NaN, 0, true
+//		Modulus{m}.Divmod(-m < n < 0) = m, -1, false if n + m rounds to m
+//		Modulus{±Inf}.Divmod(n>=0) = n, 0, false
+//		Modulus{±Inf}.Divmod(n<0) = +Inf, -1, false
+//		Modulus{m}.Divmod(±Inf) = NaN, 0, true
+//		Modulus{m}.Divmod(NaN) = NaN, 0, true
+func (m Modulus[T]) Divmod(n T) (r T, q int64, overflow bool) {
+	r = m.Congruent(n)
+	switch {
+	case r != r:
+		return r, 0, true
+	case isInf(m.mod):
+		if n < 0 {
+			return r, -1, false
+		}
+		return r, 0, false
+	}
+
+	hi, lo, rem, ok := m.quotient(n)
+	if n >= 0 {
+		if !ok || hi != 0 || lo > math.MaxInt64 {
+			return r, math.MaxInt64, true
+		}
+		return r, int64(lo), false
+	}
+
+	// floor(-a) = -ceil(a)
+	if rem != 0 {
+		var c uint64
+		lo, c = bits.Add64(lo, 1, 0)
+		hi += c
+	}
+	if !ok || hi != 0 || lo > 1<<63 {
+		return r, math.MinInt64, true
+	}
+	return r, -int64(lo), false
+}
+
+// DivmodBig is Divmod with a quotient of any size.
+//
+// Special cases:
+//		Modulus{NaN}.DivmodBig(n) = NaN, nil
+//		Modulus{m}.DivmodBig(-m < n < 0) = m, -1 if n + m rounds to m
+//		Modulus{±Inf}.DivmodBig(n>=0) = n, 0
+//		Modulus{±Inf}.DivmodBig(n<0) = +Inf, -1
+//		Modulus{m}.DivmodBig(±Inf) = NaN, nil
+//		Modulus{m}.DivmodBig(NaN) = NaN, nil
+func (m Modulus[T]) DivmodBig(n T) (r T, q *big.Int) {
+	r, qi, overflow := m.Divmod(n)
+	switch {
+	case r != r:
+		return r, nil
+	case !overflow:
+		return r, big.NewInt(qi)
+	}
+
+	nfr, nexp := frexp(n)
+	q = new(big.Int).SetUint64(nfr)
+	q.Lsh(q, m.shift(nexp))
+	q, rem := q.QuoRem(q, new(big.Int).SetUint64(m.fr), new(big.Int))
+	if n < 0 {
+		q.Neg(q)
+		if rem.Sign() != 0 {
+			q.Sub(q, big.NewInt(1))
+		}
+	}
+	return r, q
+}
+
+// quotient returns floor(|n| / m) as a 128 bit number, and the remainder in units of the modulus's fraction.
+// If |n| < m, the remainder is n's fraction instead, which is only useful for comparing with 0.
+// ok is false if the quotient needs more than 128 bits.
+func (m Modulus[T]) quotient(n T) (hi, lo, rem uint64, ok bool) {
+	if abs(n) < m.mod {
+		fr, _ := frexp(n)
+		return 0, 0, fr, true
+	}
+
+	// |n| / m = nfr * 2**k / fr
+	nfr, nexp := frexp(n)
+	k := m.shift(nexp)
+	if uint(bits.Len64(nfr))+k > 128 {
+		return 0, 0, 0, false
+	}
+	hi, lo = shl128(0, nfr, k)
+	q1, r1 := hi/m.fr, hi%m.fr
+	q0, r0 := bits.Div64(r1, lo, m.fr)
+	return q1, q0, r0, true
+}
+
+// shift returns the difference between the exponent nexp of a number at least as large as m and the modulus's exponent.
+func (m Modulus[T]) shift(nexp uint) uint {
+	mexp := m.exp
+	if mexp == 0 {
+		mexp = 1 // Denormalised numbers share the smallest exponent.
+	}
+	if nexp == 0 {
+		nexp = 1
+	}
+	return nexp - mexp
+}
@@ -0,0 +1,181 @@
+package modular_test
+
+import (
+	"math"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/stewi1014/modular"
+)
+
+func TestModulus_Divmod(t *testing.T) {
+	tests := []struct {
+		name     string
+		modulus  float64
+		arg      float64
+		wantR    float64
+		wantQ    int64
+		overflow bool
+	}{
+		{
+			name:    "Basic test",
+			modulus: 10,
+			arg:     25,
+			wantR:   5,
+			wantQ:   2,
+		},
+		{
+			name:    "Negative number",
+			modulus: 10,
+			arg:     -25,
+			wantR:   5,
+			wantQ:   -3,
+		},
+		{
+			name:    "Negative multiple",
+			modulus: 10,
+			arg:     -30,
+			wantR:   0,
+			wantQ:   -3,
+		},
+		{
+			name:    "Small negative number",
+			modulus: 1,
+			arg:     -1e-300,
+			wantR:   1,
+			wantQ:   -1,
+		},
+		{
+			name:    "Smallest negative number",
+			modulus: 0.1,
+			arg:     -math.SmallestNonzeroFloat64,
+			wantR:   0.1,
+			wantQ:   -1,
+		},
+		{
+			name:    "Fractional modulus",
+			modulus: 0.1,
+			arg:     1,
+			wantR:   0.09999999999999995,
+			wantQ:   9,
+		},
+		{
+			name:    "Largest quotient",
+			modulus: 1,
+			arg:     0x1p63 - 1024,
+			wantR:   0,
+			wantQ:   math.MaxInt64 - 1023,
+		},
+		{
+			name:     "Overflow",
+			modulus:  1,
+			arg:      0x1p63,
+			wantR:    0,
+			wantQ:    math.MaxInt64,
+			overflow: true,
+		},
+		{
+			name:    "Smallest quotient",
+			modulus: 0.5,
+			arg:     -0x1p62,
+			wantR:   0,
+			wantQ:   math.MinInt64,
+		},
+		{
+			name:     "Negative overflow",
+			modulus:  0.5,
+			arg:      -0x1p62 - 1024,
+			wantR:    0,
+			wantQ:    math.MinInt64,
+			overflow: true,
+		},
+		{
+			name:    "Denormalised modulus",
+			modulus: 3 * math.SmallestNonzeroFloat64,
+			arg:     100 * math.SmallestNonzeroFloat64,
+			wantR:   math.SmallestNonzeroFloat64,
+			wantQ:   33,
+		},
+		{
+			name:    "Infinite modulus",
+			modulus: math.Inf(1),
+			arg:     -1,
+			wantR:   math.Inf(1),
+			wantQ:   -1,
+		},
+		{
+			name:     "NaN number",
+			modulus:  1,
+			arg:      math.NaN(),
+			wantR:    math.NaN(),
+			overflow: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := modular.NewModulus(tt.modulus)
+			r, q, overflow := m.Divmod(tt.arg)
+			if (r != tt.wantR && !(math.IsNaN(r) && math.IsNaN(tt.wantR))) || q != tt.wantQ || overflow != tt.overflow {
+				t.Errorf("Modulus{%v}.Divmod(%v) = %v, %v, %v, want %v, %v, %v", tt.modulus, tt.arg, r, q, overflow, tt.wantR, tt.wantQ, tt.overflow)
+			}
+		})
+	}
+}
+
+func TestModulus_DivmodBig(t *testing.T) {
+	check := func(mod, n float64, q *big.Int, r float64, float32 bool) {
+		t.Helper()
+		if q == nil {
+			t.Fatalf("Modulus{%v}.DivmodBig(%v) quotient = nil", mod, n)
+		}
+		// n - q*m must be exact, in [0, m), and round to r.
+		bm := new(big.Rat).SetFloat64(mod)
+		exact := new(big.Rat).SetInt(q)
+		exact.Sub(new(big.Rat).SetFloat64(n), exact.Mul(exact, bm))
+		f, _ := exact.Float64()
+		if float32 {
+			f32, _ := exact.Float32()
+			f = float64(f32)
+		}
+		if exact.Sign() < 0 || exact.Cmp(bm) >= 0 || f != r {
+			t.Fatalf("Modulus{%v}.DivmodBig(%v) = %v, %v, leaving %v", mod, n, r, q, exact.FloatString(20))
+		}
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < randomTestNum/4; i++ {
+		mod, n := math.Abs(randomFloat64(r)), randomFloat64(r)
+		if mod == 0 {
+			continue
+		}
+		m := modular.NewModulus(mod)
+		rb, qb := m.DivmodBig(n)
+		check(mod, n, qb, rb, false)
+
+		ri, qi, overflow := m.Divmod(n)
+		if ri != rb || overflow == qb.IsInt64() || !overflow && qi != qb.Int64() {
+			t.Fatalf("Modulus{%v}.Divmod(%v) = %v, %v, %v, want %v, %v", mod, n, ri, qi, overflow, rb, qb)
+		}
+	}
+
+	for i := 0; i < randomTestNum/4; i++ {
+		mod, n := randomFloat32(r), randomFloat32(r)
+		if mod == 0 {
+			continue
+		}
+		rb, qb := modular.NewModulus(mod).DivmodBig(n)
+		check(math.Abs(float64(mod)), float64(n), qb, float64(rb), true)
+	}
+
+	m := modular.NewModulus(0.1)
+	if r, q := m.DivmodBig(-1e300); q.BitLen() < 1000 || r != m.Congruent(-1e300) {
+		t.Errorf("Modulus{0.1}.DivmodBig(-1e300) = %v, %v", r, q)
+	}
+	if r, q := modular.NewModulus(1.0).DivmodBig(-1e-300); r != 1 || q.Cmp(big.NewInt(-1)) != 0 {
+		t.Errorf("Modulus{1}.DivmodBig(-1e-300) = %v, %v, want 1, -1", r, q)
+	}
+	if _, q := m.DivmodBig(math.Inf(1)); q != nil {
+		t.Errorf("Modulus{0.1}.DivmodBig(+Inf) quotient = %v, want nil", q)
+	}
+}
@@ -0,0 +1,159 @@
+package modular
+
+import (
+	"math"
+	"math/bits"
+	"unsafe"
+)
+
+// Float is the set of floating point types a Modulus can be defined over.
+type Float interface {
+	float32 | float64
+}
+
+const (
+	f32ExponentBits = 8
+	f32FractionBits = 23
+
+	f64ExponentBits = 11
+	f64FractionBits = 52
+)
+
+// The following helpers take the size of the float type in bytes,
+// which lets the compiler fold them to constants in each instantiation.
+// Generic callers pass unsafe.Sizeof of a value of the type parameter.
+// Bit conversions likewise go through unsafe rather than math.Float64bits and friends,
+// as conversions between type parameters and concrete float types are too costly to inline.
+
+// exponentBits returns the number of exponent bits in a float of the given size.
+func exponentBits(size uintptr) uint {
+	if size == 4 {
+		return f32ExponentBits
+	}
+	return f64ExponentBits
+}
+
+// fractionBits returns the number of explicit fraction bits in a float of the given size.
+func fractionBits(size uintptr) uint {
+	if size == 4 {
+		return f32FractionBits
+	}
+	return f64FractionBits
+}
+
+// maxExp returns the biased exponent used for Inf and NaN in a float of the given size.
+func maxExp(size uintptr) uint {
+	return (1 << exponentBits(size)) - 1
+}
+
+// maxIndex returns the largest index an Indexer over a float of the given size supports.
+// It is an int64 as it doesn't fit in an int on 32 bit platforms, where any int is small enough for float64.
+func maxIndex(size uintptr) int64 {
+	if size == 4 {
+		return 1 << 16
+	}
+	return 1 << 32
+}
+
+// absBits returns the IEEE 754 binary representation of |f|.
+func absBits[T Float](f T) uint64 {
+	if unsafe.Sizeof(f) == 4 {
+		return uint64(*(*uint32)(unsafe.Pointer(&f)) &^ (1 << 31))
+	}
+	return *(*uint64)(unsafe.Pointer(&f)) &^ (1 << 63)
+}
+
+// floatFromBits returns the float with the IEEE 754 binary representation b.
+func floatFromBits[T Float](b uint64) (f T) {
+	if unsafe.Sizeof(f) == 4 {
+		*(*uint32)(unsafe.Pointer(&f)) = uint32(b)
+		return f
+	}
+	*(*uint64)(unsafe.Pointer(&f)) = b
+	return f
+}
+
+// shiftSub shifts n up by up-down
+func shiftSub(up, down uint, n uint64) uint64 {
+	if up > down {
+		return n << (up - down)
+	}
+	return n >> (down - up)
+}
+
+// frexp splits a float into it's exponent and fraction component. Sign bit is discarded.
+// The implied bit is placed in the fraction if appropriate
+func frexp[T Float](f T) (uint64, uint) {
+	return frexpBits(absBits(f), fractionBits(unsafe.Sizeof(f)))
+}
+
+// frexpBits is frexp on the binary representation of a positive float with the given fraction width.
+func frexpBits(fbits uint64, fracBits uint) (uint64, uint) {
+	exp := uint(fbits >> fracBits)
+	fr := fbits & (1<<fracBits - 1)
+	if exp != 0 {
+		fr |= 1 << fracBits
+	}
+	return fr, exp
+}
+
+// ldexp assembles a float from an exponent and fraction component. Sign is ignored.
+// Expects the implied bit to be set if appropriate.
+func ldexp[T Float](fr uint64, exp uint) (f T) {
+	return floatFromBits[T](ldexpBits(fr, exp, fractionBits(unsafe.Sizeof(f))))
+}
+
+// ldexpBits is ldexp returning the binary representation of a float with the given fraction width.
+func ldexpBits(fr uint64, exp uint, fracBits uint) uint64 {
+	if exp == 0 || fr == 0 {
+		return fr
+	}
+	shift := uint(bits.LeadingZeros64(fr)) - (63 - fracBits)
+	if shift >= exp {
+		shift = exp - 1 // Denormalised; the implied bit is never set, so the exponent stays at 0.
+	}
+	// Adding rather than or-ing lets the implied bit carry into the exponent.
+	return uint64(exp-shift-1)<<fracBits + fr<<shift
+}
+
+// abs returns the absolute value of f.
+func abs[T Float](f T) T {
+	return T(math.Abs(float64(f)))
+}
+
+// nan returns a NaN of type T.
+func nan[T Float]() T {
+	return T(math.NaN())
+}
+
+// isInf reports whether f is an infinity.
+func isInf[T Float](f T) bool {
+	return math.IsInf(float64(f), 0)
+}
+
+// roundBits returns the binary representation of mant * 2**exp rounded to the nearest float of the given size, ties to even.
+// mant must have its top bit set, and sticky reports whether there are non-zero bits below it.
+// The result must not overflow.
+func roundBits(mant uint64, exp int, sticky bool, size uintptr) uint64 {
+	fracBits := fractionBits(size)
+	biased := exp + 63 + int(maxExp(size)/2)
+
+	shift := 63 - fracBits
+	base := uint(0)
+	if biased >= 1 {
+		base = uint(biased - 1)
+	} else {
+		shift += uint(1 - biased) // Denormalised
+	}
+	if shift > 64 {
+		return 0 // Less than half the smallest denormal
+	}
+
+	m := mant >> shift
+	rem, half := mant&(1<<shift-1), uint64(1)<<(shift-1)
+	if rem > half || rem == half && (sticky || m&1 != 0) {
+		m++
+	}
+	// Adding rather than or-ing lets the implied bit, and any carry from rounding, carry into the exponent.
+	return uint64(base)<<fracBits + m
+}
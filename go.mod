@@ -1,9 +1,11 @@
 module github.com/stewi1014/modular
 
-go 1.12
+go 1.18
 
 require (
 	github.com/bmkessler/fastdiv v0.0.0-20190227075523-41d5178f2044
 	github.com/chewxy/math32 v1.0.0
 	github.com/go-gl/mathgl v0.0.0-20190713194549-592312d8590a
 )
+
+require golang.org/x/image v0.0.0-20190321063152-3fc05d484e9f // indirect
@@ -0,0 +1,131 @@
+package modular
+
+import (
+	"errors"
+	"math/bits"
+	"unsafe"
+)
+
+// Error types
+var (
+	ErrBadModulo  = errors.New("bad modulus")
+	ErrBadIndex   = errors.New("bad index")
+	ErrNotCoprime = errors.New("not coprime")
+	ErrNoSolution = errors.New("no solution")
+	ErrOverflow   = errors.New("overflow")
+)
+
+// NewIndexer creates a new Indexer.
+//
+// index must not be larger than 2**16 for float32 or 2**32 for float64, and modulus must be a normalised float.
+//
+// Special cases:
+//		NewIndexer(m, 0) = ErrBadIndex
+//		NewIndexer(m, i > 2**16) = ErrBadIndex for float32
+//		NewIndexer(m, i > 2**32) = ErrBadIndex for float64
+//		NewIndexer(0, i) = ErrBadModulo
+//		NewIndexer(±Inf, i) = ErrBadModulo
+//		NewIndexer(NaN, i) = ErrBadModulo
+//		NewIndexer(m, i) = ErrBadModulo for |m| < 2**-126 for float32
+//		NewIndexer(m, i) = ErrBadModulo for |m| < 2**-1022 for float64
+func NewIndexer[T Float](modulus T, index int) (Indexer[T], error) {
+	if modulus == 0 {
+		return Indexer[T]{}, ErrBadModulo
+	}
+	mod := NewModulus(modulus)
+	return mod.NewIndexer(index)
+}
+
+// NewIndexer creates a new indexer from the Modulus.
+func (m Modulus[T]) NewIndexer(index int) (Indexer[T], error) {
+	if isInf(m.mod) || m.mod != m.mod || m.exp == 0 {
+		return Indexer[T]{}, ErrBadModulo
+	}
+	if int64(index) > maxIndex(unsafe.Sizeof(m.mod)) || index < 1 {
+		return Indexer[T]{}, ErrBadIndex
+	}
+
+	return Indexer[T]{
+		Modulus: m,
+		i:       index,
+	}, nil
+}
+
+// Indexer provides a fast method for mapping a floating point modulus to a range of integers.
+type Indexer[T Float] struct {
+	Modulus[T]
+	i int
+}
+
+// Indexes returns the number of indexes the modulus is mapped to.
+func (i Indexer[T]) Indexes() int {
+	return i.i
+}
+
+// Index indexes n.
+//
+// If n is NaN or ±Inf, it returns the index.
+// Otherwise, it returns floor(index * (n mod m) / m), computed exactly,
+// which always satisfies 0 <= num < index.
+//
+// Special cases:
+//		Index(NaN) = index
+//		Index(±Inf) = index
+func (i Indexer[T]) Index(n T) int {
+	if n != n || isInf(n) || i.i == 0 {
+		return i.i
+	}
+
+	index := uint64(i.i)
+	nfr, nexp := frexp(n)
+	if n >= i.mod || n <= -i.mod {
+		// (n mod m) / m = rfr / fr
+		// Integer moduli use modExp, as reducing n with integer division first is slower.
+		var rfr uint64
+		if i.special != nil && i.special.kind == pow2Reducer {
+			// The fraction of a power of two is a single bit, so the remainder is the bits below it.
+			rfr = nfr << (nexp - i.exp) & (i.fr - 1)
+		} else {
+			rfr = i.modExp(nfr, nexp-i.exp)
+		}
+		if n < 0 && rfr != 0 {
+			rfr = i.fr - rfr
+		}
+		return int(i.div128(bits.Mul64(rfr, index)))
+	}
+
+	// |n| / m = nfr / (fr * 2**d), and floor(a / (fr * 2**d)) = floor(floor(a / 2**d) / fr).
+	if nexp == 0 {
+		nexp = 1 // Denormalised numbers share the smallest exponent.
+	}
+	d := i.exp - nexp
+	hi, lo := bits.Mul64(nfr, index)
+	if n < 0 {
+		// floor(index - a / (fr * 2**d)) = index - 1 - floor((a - 1) / (fr * 2**d)) for a > 0
+		var b uint64
+		lo, b = bits.Sub64(lo, 1, 0)
+		hi -= b
+		return int(index - 1 - i.div128(shr128(hi, lo, d)))
+	}
+	return int(i.div128(shr128(hi, lo, d)))
+}
+
+// div128 returns floor(hi, lo / fr) for hi < fr.
+// The inverse of the fraction is exact for 64 bit numerators, which is always the case for float32,
+// and for float64 when the index is small; otherwise it falls back to 128 bit division.
+func (m fraction) div128(hi, lo uint64) uint64 {
+	if hi == 0 {
+		return m.fd.Div(lo)
+	}
+	q, _ := bits.Div64(hi, lo, m.fr)
+	return q
+}
+
+// IndexSlice stores the index of src[i] in dst[i].
+// dst must be at least as long as src.
+func (i Indexer[T]) IndexSlice(dst []int, src []T) {
+	dst = dst[:len(src)]
+	for j, n := range src {
+		dst[j] = i.Index(n)
+	}
+}
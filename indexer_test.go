@@ -0,0 +1,189 @@
+package modular_test
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stewi1014/modular"
+	"github.com/stewi1014/modular/modulartest"
+)
+
+var (
+	intSink int
+)
+
+func TestIndexer_Index(t *testing.T) {
+	tests := []struct {
+		name    string
+		modulus float64
+		index   int
+		n       float64
+		want    int
+		wantErr error
+	}{
+		{
+			name:    "Basic test",
+			modulus: 15,
+			index:   15,
+			n:       1,
+			want:    1,
+		},
+		{
+			name:    "Negative Number larger than modulus",
+			modulus: 200,
+			index:   100,
+			n:       -202,
+			want:    99,
+		},
+		{
+			name:    "Large number",
+			modulus: 10,
+			index:   20,
+			n:       98723456,
+			want:    12,
+		},
+		{
+			name:    "NaN Number",
+			modulus: 23,
+			index:   10054,
+			n:       math.NaN(),
+			want:    10054,
+		},
+		{
+			name:    "Zero index",
+			modulus: 23,
+			index:   0,
+			wantErr: modular.ErrBadIndex,
+		},
+		{
+			name:    "The modulus itself",
+			modulus: 0.1,
+			index:   10,
+			n:       0.1,
+			want:    0,
+		},
+		{
+			name:    "Tiny negative number",
+			modulus: 3,
+			index:   3,
+			n:       -1e-30,
+			want:    2,
+		},
+		{
+			name:    "Zero modulus",
+			modulus: 0,
+			index:   10,
+			wantErr: modular.ErrBadModulo,
+		},
+		{
+			name:    "NaN Modulus",
+			modulus: math.NaN(),
+			index:   10,
+			wantErr: modular.ErrBadModulo,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name+" float64", func(t *testing.T) {
+			i, err := modular.NewIndexer(tt.modulus, tt.index)
+			if got := i.Index(tt.n); got != tt.want || err != tt.wantErr {
+				t.Errorf("Indexer.Index(%v) = %v, want %v\nNewIndex error: \"%v\", want \"%v\"; Modulus: %v; Index: %v", tt.n, got, tt.want, err, tt.wantErr, tt.modulus, tt.index)
+			}
+			if got := i.Indexes(); err == nil && got != tt.index {
+				t.Errorf("Indexer.Indexes() = %v, want %v", got, tt.index)
+			}
+		})
+		t.Run(tt.name+" float32", func(t *testing.T) {
+			i, err := modular.NewIndexer(float32(tt.modulus), tt.index)
+			if got := i.Index(float32(tt.n)); got != tt.want || err != tt.wantErr {
+				t.Errorf("Indexer.Index(%v) = %v, want %v\nNewIndex error: \"%v\", want \"%v\"; Modulus: %v; Index: %v", tt.n, got, tt.want, err, tt.wantErr, tt.modulus, tt.index)
+			}
+		})
+	}
+
+	t.Run("Index limits", func(t *testing.T) {
+		if _, err := modular.NewIndexer(float32(1), 1<<16+1); err != modular.ErrBadIndex {
+			t.Errorf("NewIndexer[float32](1, 2**16+1) error = %v, want %v", err, modular.ErrBadIndex)
+		}
+		if _, err := modular.NewIndexer(float64(1), 1<<16+1); err != nil {
+			t.Errorf("NewIndexer[float64](1, 2**16+1) error = %v, want nil", err)
+		}
+	})
+}
+
+func TestIndexer_IndexSpecialised(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for _, mod := range []float64{1, 1024, 0.25, 0x1p-1022, 3, 360, 1e6, 0x1p62 * 1.5} {
+		i, _ := modular.NewIndexer(mod, 1000)
+		for j := 0; j < randomTestNum/10; j++ {
+			n := (r.Float64()*2 - 1) * math.Ldexp(mod, r.Intn(1100))
+			if math.IsInf(n, 0) {
+				continue
+			}
+			if got, want := i.Index(n), modulartest.Index(n, mod, 1000); got != want {
+				t.Fatalf("Indexer{%v}.Index(%v) = %v, want %v", mod, n, got, want)
+			}
+		}
+	}
+}
+
+func TestIndexer_IndexRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < randomTestNum/4; i++ {
+		mod := math.Abs(randomFloat64(r))
+		n := mod * (r.Float64()*2 - 1) * math.Ldexp(1, r.Intn(120)-60)
+		mod32, n32 := float32(mod), float32(n)
+		if math.IsInf(n, 0) {
+			continue
+		}
+
+		// Small indexes take the fast path for both float types, and large ones the 128 bit division for float64.
+		for _, index := range []int{1, 100, 1 << 16, math.MaxUint32 & math.MaxInt} {
+			if i, err := modular.NewIndexer(mod, index); err == nil {
+				if got, want := i.Index(n), modulartest.Index(n, mod, index); got != want {
+					t.Fatalf("Indexer{%v, %v}.Index(%v) = %v, want %v", mod, index, n, got, want)
+				}
+			}
+			if i, err := modular.NewIndexer(mod32, index); err == nil && !math.IsInf(float64(n32), 0) {
+				if got, want := i.Index(n32), modulartest.Index(n32, mod32, index); got != want {
+					t.Fatalf("Indexer{%v, %v}.Index(%v) = %v, want %v", mod32, index, n32, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestIndexer_IndexSlice(t *testing.T) {
+	src := []float64{0, 1, -202, 98723456, math.NaN(), math.Inf(-1), 14.999}
+	i, _ := modular.NewIndexer(15.0, 100)
+	dst := make([]int, len(src))
+	i.IndexSlice(dst, src)
+	for j, n := range src {
+		if want := i.Index(n); dst[j] != want {
+			t.Errorf("Indexer.IndexSlice()[%v] = %v, want %v", n, dst[j], want)
+		}
+	}
+}
+
+func BenchmarkIndexer(b *testing.B) {
+	for _, n := range benchmarks {
+		b.Run(fmt.Sprintf("Indexer.Index(%v)", n), func(b *testing.B) {
+			ind, _ := modular.NewIndexer(benchmarkModulo, 100)
+			for i := 0; i < b.N; i++ {
+				intSink = ind.Index(n)
+			}
+		})
+	}
+}
+
+func BenchmarkIndexer_LargeIndex(b *testing.B) {
+	for _, n := range benchmarks {
+		b.Run(fmt.Sprintf("Indexer.Index(%v)", n), func(b *testing.B) {
+			ind, _ := modular.NewIndexer(benchmarkModulo, math.MaxUint32&math.MaxInt)
+			for i := 0; i < b.N; i++ {
+				intSink = ind.Index(n)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package modular
+
+import (
+	"math/bits"
+)
+
+// NewIntIndexer creates a new IntIndexer.
+//
+// Special cases:
+//		NewIntIndexer(0, i) = panic(integer divide by zero)
+//		NewIntIndexer(m, i < 1) = ErrBadIndex
+func NewIntIndexer[T Integer](modulus T, index int) (IntIndexer[T], error) {
+	mod := NewIntModulus(modulus)
+	return mod.NewIndexer(index)
+}
+
+// NewIndexer creates a new indexer from the IntModulus.
+func (m IntModulus[T]) NewIndexer(index int) (IntIndexer[T], error) {
+	if index < 1 {
+		return IntIndexer[T]{}, ErrBadIndex
+	}
+
+	return IntIndexer[T]{
+		IntModulus: m,
+		i:          uint64(index),
+	}, nil
+}
+
+// IntIndexer maps an integer modulus evenly onto a range of indices.
+type IntIndexer[T Integer] struct {
+	IntModulus[T]
+	i uint64
+}
+
+// Indexes returns the number of indexes the modulus is mapped to.
+func (i IntIndexer[T]) Indexes() int {
+	return int(i.i)
+}
+
+// Index indexes n.
+//
+// It returns floor((n mod m) * index / m), which always satisfies 0 <= num < index.
+func (i IntIndexer[T]) Index(n T) int {
+	hi, lo := bits.Mul64(i.congruent(n), i.i)
+	if hi == 0 {
+		return int(i.fd.Div(lo))
+	}
+	q, _ := bits.Div64(hi, lo, i.mod)
+	return int(q)
+}
@@ -0,0 +1,74 @@
+package modular_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stewi1014/modular"
+)
+
+func TestIntIndexer_Index(t *testing.T) {
+	tests := []struct {
+		name    string
+		modulus int64
+		index   int
+		n       int64
+		want    int
+		wantErr error
+	}{
+		{
+			name:    "Basic test",
+			modulus: 24,
+			index:   3,
+			n:       13,
+			want:    1,
+		},
+		{
+			name:    "Negative number",
+			modulus: 200,
+			index:   100,
+			n:       -1,
+			want:    99,
+		},
+		{
+			name:    "More indices than modulus",
+			modulus: 10,
+			index:   1000,
+			n:       19,
+			want:    900,
+		},
+		{
+			name:    "Large product",
+			modulus: math.MaxInt64,
+			index:   math.MaxInt32,
+			n:       math.MaxInt64 - 1,
+			want:    math.MaxInt32 - 1,
+		},
+		{
+			name:    "Zero index",
+			modulus: 10,
+			index:   0,
+			wantErr: modular.ErrBadIndex,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			i, err := modular.NewIntIndexer(tt.modulus, tt.index)
+			if got := i.Index(tt.n); got != tt.want || err != tt.wantErr {
+				t.Errorf("IntIndexer.Index(%v) = %v, want %v\nNewIntIndexer error: \"%v\", want \"%v\"; Modulus: %v; Index: %v", tt.n, got, tt.want, err, tt.wantErr, tt.modulus, tt.index)
+			}
+		})
+	}
+}
+
+func BenchmarkIntIndexer(b *testing.B) {
+	for _, n := range intBenchmarks {
+		b.Run(fmt.Sprintf("Index(%v)", n), func(b *testing.B) {
+			ind, _ := modular.NewIntIndexer(intBenchmarkModulo, 100)
+			for i := 0; i < b.N; i++ {
+				intSink = ind.Index(n)
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package modular
+
+import (
+	"math/bits"
+
+	"github.com/bmkessler/fastdiv"
+)
+
+// Integer is the set of integer types an IntModulus can be defined over.
+type Integer interface {
+	int64 | uint64
+}
+
+// NewIntModulus creates a new IntModulus.
+//
+// A negative modulus is treated as its absolute value.
+//
+// Special cases:
+//		NewIntModulus(0) = panic(integer divide by zero)
+func NewIntModulus[T Integer](modulus T) IntModulus[T] {
+	mod := uint64(modulus)
+	if modulus < 0 {
+		mod = -mod
+	}
+
+	fd := fastdiv.NewUint64(mod)
+	m := IntModulus[T]{
+		intModulus: intModulus{
+			fd:  fd,
+			mod: mod,
+		},
+	}
+	if ^T(0) < 0 { // signed
+		m.wrapMod = fd.Mod(fd.Mod(^uint64(0)) + 1)
+	}
+	return m
+}
+
+// IntModulus defines a modulus over integers.
+// Like Modulus, it pre-computes the inverse of the modulus, so reduction needs no division instructions.
+type IntModulus[T Integer] struct {
+	intModulus
+}
+
+// intModulus holds the parts of an IntModulus that don't depend on the integer type.
+type intModulus struct {
+	fd      fastdiv.Uint64
+	mod     uint64
+	wrapMod uint64 // 2**64 mod m for signed moduli, 0 for unsigned
+}
+
+// Mod returns the modulus.
+//
+// Special cases:
+//		NewIntModulus(math.MinInt64).Mod() = math.MinInt64
+func (m IntModulus[T]) Mod() T {
+	return T(m.mod)
+}
+
+// Dist returns the distance and direction of n1 to n2.
+// It picks the shortest distance, preferring the positive direction when both are equal.
+//
+// The result is an int64 for both signed and unsigned moduli, as the shortest distance never exceeds half of any uint64.
+func (m IntModulus[T]) Dist(n1, n2 T) int64 {
+	d := m.sub(m.congruent(n2), m.congruent(n1))
+	if d > m.mod/2 {
+		return -int64(m.mod - d)
+	}
+	return int64(d)
+}
+
+// GetCongruent returns the closest number to n1 that is congruent to n2.
+// It wraps on overflow like ordinary integer arithmetic.
+func (m IntModulus[T]) GetCongruent(n1, n2 T) T {
+	return n1 - T(m.Dist(n2, n1))
+}
+
+// Congruent returns n mod m.
+// The result always satisfies 0 <= n < |m|.
+func (m IntModulus[T]) Congruent(n T) T {
+	return T(m.congruent(n))
+}
+
+// congruent returns n mod m as a uint64.
+func (m IntModulus[T]) congruent(n T) uint64 {
+	return m.reduce(uint64(n), uint64(int64(n)>>63))
+}
+
+// reduce returns n mod m, where n is the two's complement bits of the number and neg is all ones if it is negative.
+func (m intModulus) reduce(n, neg uint64) uint64 {
+	// n is 2**64 too large for negative numbers, so remove 2**64 mod m again.
+	// Masking with the sign keeps this branch free, as the sign of n is rarely predictable,
+	// and wrapMod is 0 for unsigned moduli.
+	r, borrow := bits.Sub64(m.fd.Mod(n), m.wrapMod&neg, 0)
+	return r + m.mod&-borrow
+}
+
+// sub returns a - b (mod m) for a and b already reduced.
+func (m intModulus) sub(a, b uint64) uint64 {
+	d, borrow := bits.Sub64(a, b, 0)
+	return d + m.mod&-borrow
+}
@@ -0,0 +1,236 @@
+package modular_test
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/stewi1014/modular"
+)
+
+var int64Sink int64
+
+// bigMod returns n mod m as a big.Int, with the result always satisfying 0 <= r < |m|.
+func bigMod(n, m *big.Int) *big.Int {
+	return new(big.Int).Mod(n, new(big.Int).Abs(m))
+}
+
+func TestIntModulus_Congruent(t *testing.T) {
+	tests := []struct {
+		name    string
+		modulus int64
+		arg     int64
+		want    int64
+	}{
+		{
+			name:    "Basic test",
+			modulus: 13,
+			arg:     58,
+			want:    6,
+		},
+		{
+			name:    "Negative number",
+			modulus: 5,
+			arg:     -34,
+			want:    1,
+		},
+		{
+			name:    "Negative multiple",
+			modulus: 5,
+			arg:     -35,
+			want:    0,
+		},
+		{
+			name:    "Negative modulo and number",
+			modulus: -5,
+			arg:     -3,
+			want:    2,
+		},
+		{
+			name:    "Modulus of one",
+			modulus: 1,
+			arg:     -3,
+			want:    0,
+		},
+		{
+			name:    "Smallest number",
+			modulus: 1000,
+			arg:     math.MinInt64,
+			want:    192,
+		},
+		{
+			name:    "Smallest modulus",
+			modulus: math.MinInt64,
+			arg:     -1,
+			want:    math.MaxInt64,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := modular.NewIntModulus(tt.modulus)
+			if got := m.Congruent(tt.arg); got != tt.want {
+				t.Errorf("IntModulus{%v}.Congruent(%v) = %v, want %v", tt.modulus, tt.arg, got, tt.want)
+			}
+		})
+	}
+
+	t.Run("Unsigned", func(t *testing.T) {
+		m := modular.NewIntModulus(uint64(math.MaxUint64))
+		if got := m.Congruent(math.MaxUint64); got != 0 {
+			t.Errorf("IntModulus{MaxUint64}.Congruent(MaxUint64) = %v, want 0", got)
+		}
+		m = modular.NewIntModulus(uint64(1e18))
+		if got, want := m.Congruent(math.MaxUint64), uint64(math.MaxUint64)%1e18; got != want {
+			t.Errorf("IntModulus{1e18}.Congruent(MaxUint64) = %v, want %v", got, want)
+		}
+	})
+}
+
+func TestIntModulus_CongruentRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	t.Run("int64", func(t *testing.T) {
+		for i := 0; i < randomTestNum; i++ {
+			mod, n := int64(r.Uint64()>>uint(r.Intn(64))), int64(r.Uint64())
+			if mod == 0 {
+				continue
+			}
+			got := modular.NewIntModulus(mod).Congruent(n)
+			if want := bigMod(big.NewInt(n), big.NewInt(mod)); big.NewInt(got).Cmp(want) != 0 {
+				t.Fatalf("IntModulus{%v}.Congruent(%v) = %v, want %v", mod, n, got, want)
+			}
+		}
+	})
+
+	t.Run("uint64", func(t *testing.T) {
+		for i := 0; i < randomTestNum; i++ {
+			mod, n := r.Uint64()>>uint(r.Intn(64)), r.Uint64()
+			if mod == 0 {
+				continue
+			}
+			if got, want := modular.NewIntModulus(mod).Congruent(n), n%mod; got != want {
+				t.Fatalf("IntModulus{%v}.Congruent(%v) = %v, want %v", mod, n, got, want)
+			}
+		}
+	})
+}
+
+func TestIntModulus_Dist(t *testing.T) {
+	tests := []struct {
+		name    string
+		modulus int64
+		n1, n2  int64
+		want    int64
+	}{
+		{
+			name:    "Forwards over 0",
+			modulus: 100,
+			n1:      90,
+			n2:      20,
+			want:    30,
+		},
+		{
+			name:    "Backwards over 0",
+			modulus: 100,
+			n1:      10,
+			n2:      -10,
+			want:    -20,
+		},
+		{
+			name:    "Half way",
+			modulus: 100,
+			n1:      80,
+			n2:      30,
+			want:    50,
+		},
+		{
+			name:    "Difference overflows",
+			modulus: 7,
+			n1:      math.MinInt64,
+			n2:      math.MaxInt64,
+			want:    1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := modular.NewIntModulus(tt.modulus)
+			if got := m.Dist(tt.n1, tt.n2); got != tt.want {
+				t.Errorf("IntModulus.Dist(%v, %v) = %v, want %v (mod %v)", tt.n1, tt.n2, got, tt.want, tt.modulus)
+			}
+		})
+	}
+
+	t.Run("Unsigned", func(t *testing.T) {
+		m := modular.NewIntModulus(uint64(math.MaxUint64))
+		if got := m.Dist(1, math.MaxUint64-1); got != -2 {
+			t.Errorf("IntModulus{MaxUint64}.Dist(1, MaxUint64-1) = %v, want -2", got)
+		}
+	})
+}
+
+func TestIntModulus_GetCongruent(t *testing.T) {
+	tests := []struct {
+		name    string
+		modulus int64
+		n1, n2  int64
+		want    int64
+	}{
+		{
+			name:    "Backwards",
+			modulus: 100,
+			n1:      230,
+			n2:      20,
+			want:    220,
+		},
+		{
+			name:    "Over 0",
+			modulus: 100,
+			n1:      -310,
+			n2:      20,
+			want:    -280,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := modular.NewIntModulus(tt.modulus)
+			if got := m.GetCongruent(tt.n1, tt.n2); got != tt.want {
+				t.Errorf("IntModulus.GetCongruent(%v, %v) = %v, want %v (mod %v)", tt.n1, tt.n2, got, tt.want, tt.modulus)
+			}
+		})
+	}
+}
+
+var intBenchmarkModulo = int64(1e9 + 7)
+var intBenchmarks = []int64{
+	12345,
+	-12345,
+	math.MaxInt64,
+}
+
+func BenchmarkInt_Mod(b *testing.B) {
+	for _, n := range intBenchmarks {
+		b.Run(fmt.Sprintf("%%(%v)", n), func(b *testing.B) {
+			m := intBenchmarkModulo
+			for i := 0; i < b.N; i++ {
+				r := n % m
+				if r < 0 {
+					r += m
+				}
+				int64Sink = r
+			}
+		})
+	}
+}
+
+func BenchmarkIntModulus(b *testing.B) {
+	for _, n := range intBenchmarks {
+		b.Run(fmt.Sprintf("Congruent(%v)", n), func(b *testing.B) {
+			m := modular.NewIntModulus(intBenchmarkModulo)
+			for i := 0; i < b.N; i++ {
+				int64Sink = m.Congruent(n)
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package modular
+
+import (
+	"math/bits"
+)
+
+// AddMod returns a + b (mod m).
+// The result always satisfies 0 <= r < |m|, and never overflows.
+func (m IntModulus[T]) AddMod(a, b T) T {
+	return T(m.add(m.congruent(a), m.congruent(b)))
+}
+
+// SubMod returns a - b (mod m).
+// The result always satisfies 0 <= r < |m|, and never overflows.
+func (m IntModulus[T]) SubMod(a, b T) T {
+	return T(m.sub(m.congruent(a), m.congruent(b)))
+}
+
+// AddReduced returns a + b (mod m) for a and b already reduced, such as the results of Congruent.
+// It skips reducing its arguments, so it suits inner loops that keep everything reduced.
+// Passing other values gives undefined results.
+func (m IntModulus[T]) AddReduced(a, b T) T {
+	return T(m.add(uint64(a), uint64(b)))
+}
+
+// SubReduced returns a - b (mod m) for a and b already reduced, such as the results of Congruent.
+// Passing other values gives undefined results.
+func (m IntModulus[T]) SubReduced(a, b T) T {
+	return T(m.sub(uint64(a), uint64(b)))
+}
+
+// MulMod returns a * b (mod m).
+// The full 128 bit product is reduced, so the result never overflows.
+func (m IntModulus[T]) MulMod(a, b T) T {
+	return T(m.mul(m.congruent(a), m.congruent(b)))
+}
+
+// Neg returns -a (mod m).
+func (m IntModulus[T]) Neg(a T) T {
+	return T(m.sub(0, m.congruent(a)))
+}
+
+// PowMod returns a**e (mod m) by square-and-multiply.
+//
+// Special cases:
+//		PowMod(a, 0) = 1 mod m
+func (m IntModulus[T]) PowMod(a T, e uint64) T {
+	return T(m.pow(m.congruent(a), e))
+}
+
+// InverseMod returns the multiplicative inverse of a (mod m);
+// the number x satisfying a * x = 1 (mod m).
+//
+// Special cases:
+//		InverseMod(a) = ErrNotCoprime if a and m share a common factor
+func (m IntModulus[T]) InverseMod(a T) (T, error) {
+	inv, ok := m.inverse(m.congruent(a))
+	if !ok {
+		return 0, ErrNotCoprime
+	}
+	return T(inv), nil
+}
+
+// add returns a + b (mod m) for a and b already reduced.
+func (m intModulus) add(a, b uint64) uint64 {
+	s, carry := bits.Add64(a, b, 0)
+	if carry != 0 || s >= m.mod {
+		s -= m.mod
+	}
+	return s
+}
+
+// mul returns a * b (mod m) for a and b already reduced.
+func (m intModulus) mul(a, b uint64) uint64 {
+	hi, lo := bits.Mul64(a, b)
+	_, r := bits.Div64(hi, lo, m.mod) // hi < m as a, b < m
+	return r
+}
+
+// pow returns a**e (mod m) for a already reduced.
+func (m intModulus) pow(a, e uint64) uint64 {
+	r := m.fd.Mod(1)
+	for ; e != 0; e >>= 1 {
+		if e&1 != 0 {
+			r = m.mul(r, a)
+		}
+		a = m.mul(a, a)
+	}
+	return r
+}
+
+// inverse returns the inverse of a (mod m) for a already reduced, and false if it doesn't exist.
+func (m intModulus) inverse(a uint64) (uint64, bool) {
+	// Extended Euclid, keeping the coefficients of a reduced mod m rather than signed,
+	// as they can be as large as m itself.
+	r0, r1 := m.mod, a
+	t0, t1 := uint64(0), uint64(1)
+	for r1 != 0 {
+		q := r0 / r1
+		r0, r1 = r1, r0-q*r1
+		t0, t1 = t1, m.sub(t0, m.mul(m.fd.Mod(q), t1))
+	}
+	if r0 != 1 {
+		return 0, false
+	}
+	return t0, true
+}
@@ -0,0 +1,232 @@
+package modular_test
+
+import (
+	"math"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/stewi1014/modular"
+)
+
+func TestIntModulus_Ring(t *testing.T) {
+	tests := []struct {
+		name    string
+		modulus int64
+		a, b    int64
+		add     int64
+		sub     int64
+		mul     int64
+		neg     int64
+	}{
+		{
+			name:    "Small numbers",
+			modulus: 7,
+			a:       5,
+			b:       4,
+			add:     2,
+			sub:     1,
+			mul:     6,
+			neg:     2,
+		},
+		{
+			name:    "Negative numbers",
+			modulus: 7,
+			a:       -5,
+			b:       4,
+			add:     6,
+			sub:     5,
+			mul:     1,
+			neg:     5,
+		},
+		{
+			name:    "Product overflows",
+			modulus: math.MaxInt64,
+			a:       math.MaxInt64 - 1,
+			b:       math.MaxInt64 - 1,
+			add:     math.MaxInt64 - 2,
+			sub:     0,
+			mul:     1,
+			neg:     1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := modular.NewIntModulus(tt.modulus)
+			if got := m.AddMod(tt.a, tt.b); got != tt.add {
+				t.Errorf("IntModulus.AddMod(%v, %v) = %v, want %v (mod %v)", tt.a, tt.b, got, tt.add, tt.modulus)
+			}
+			if got := m.SubMod(tt.a, tt.b); got != tt.sub {
+				t.Errorf("IntModulus.SubMod(%v, %v) = %v, want %v (mod %v)", tt.a, tt.b, got, tt.sub, tt.modulus)
+			}
+			if got := m.MulMod(tt.a, tt.b); got != tt.mul {
+				t.Errorf("IntModulus.MulMod(%v, %v) = %v, want %v (mod %v)", tt.a, tt.b, got, tt.mul, tt.modulus)
+			}
+			if got := m.Neg(tt.a); got != tt.neg {
+				t.Errorf("IntModulus.Neg(%v) = %v, want %v (mod %v)", tt.a, got, tt.neg, tt.modulus)
+			}
+		})
+	}
+
+	t.Run("Unsigned sum overflows", func(t *testing.T) {
+		m := modular.NewIntModulus(uint64(math.MaxUint64))
+		if got := m.AddMod(math.MaxUint64-1, math.MaxUint64-1); got != math.MaxUint64-2 {
+			t.Errorf("IntModulus{MaxUint64}.AddMod(MaxUint64-1, MaxUint64-1) = %v, want %v", got, uint64(math.MaxUint64-2))
+		}
+	})
+}
+
+func TestIntModulus_RingRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < randomTestNum; i++ {
+		mod, a, b := r.Uint64()>>uint(r.Intn(64)), r.Uint64(), r.Uint64()
+		if mod == 0 {
+			continue
+		}
+		m := modular.NewIntModulus(mod)
+		bm, ba, bb := new(big.Int).SetUint64(mod), new(big.Int).SetUint64(a), new(big.Int).SetUint64(b)
+
+		want := bigMod(new(big.Int).Add(ba, bb), bm)
+		if got := m.AddMod(a, b); new(big.Int).SetUint64(got).Cmp(want) != 0 {
+			t.Fatalf("IntModulus{%v}.AddMod(%v, %v) = %v, want %v", mod, a, b, got, want)
+		}
+		want = bigMod(new(big.Int).Sub(ba, bb), bm)
+		if got := m.SubMod(a, b); new(big.Int).SetUint64(got).Cmp(want) != 0 {
+			t.Fatalf("IntModulus{%v}.SubMod(%v, %v) = %v, want %v", mod, a, b, got, want)
+		}
+		ra, rb := m.Congruent(a), m.Congruent(b)
+		if got, want := m.AddReduced(ra, rb), m.AddMod(a, b); got != want {
+			t.Fatalf("IntModulus{%v}.AddReduced(%v, %v) = %v, want %v", mod, ra, rb, got, want)
+		}
+		if got, want := m.SubReduced(ra, rb), m.SubMod(a, b); got != want {
+			t.Fatalf("IntModulus{%v}.SubReduced(%v, %v) = %v, want %v", mod, ra, rb, got, want)
+		}
+		want = bigMod(new(big.Int).Mul(ba, bb), bm)
+		if got := m.MulMod(a, b); new(big.Int).SetUint64(got).Cmp(want) != 0 {
+			t.Fatalf("IntModulus{%v}.MulMod(%v, %v) = %v, want %v", mod, a, b, got, want)
+		}
+		e := b >> uint(r.Intn(64))
+		want = new(big.Int).Exp(ba, new(big.Int).SetUint64(e), bm)
+		if got := m.PowMod(a, e); new(big.Int).SetUint64(got).Cmp(want) != 0 {
+			t.Fatalf("IntModulus{%v}.PowMod(%v, %v) = %v, want %v", mod, a, e, got, want)
+		}
+
+		want = new(big.Int).ModInverse(ba, bm)
+		got, err := m.InverseMod(a)
+		switch {
+		case want == nil && err != modular.ErrNotCoprime:
+			t.Fatalf("IntModulus{%v}.InverseMod(%v) = %v, %v, want %v", mod, a, got, err, modular.ErrNotCoprime)
+		case want != nil && (err != nil || new(big.Int).SetUint64(got).Cmp(bigMod(want, bm)) != 0):
+			t.Fatalf("IntModulus{%v}.InverseMod(%v) = %v, %v, want %v", mod, a, got, err, want)
+		}
+	}
+}
+
+func TestIntModulus_PowMod(t *testing.T) {
+	tests := []struct {
+		name    string
+		modulus int64
+		a       int64
+		e       uint64
+		want    int64
+	}{
+		{
+			name:    "Zero exponent",
+			modulus: 13,
+			a:       5,
+			e:       0,
+			want:    1,
+		},
+		{
+			name:    "Zero exponent modulus of one",
+			modulus: 1,
+			a:       5,
+			e:       0,
+			want:    0,
+		},
+		{
+			name:    "Fermat",
+			modulus: 1e9 + 7,
+			a:       -12345,
+			e:       1e9 + 6,
+			want:    1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := modular.NewIntModulus(tt.modulus)
+			if got := m.PowMod(tt.a, tt.e); got != tt.want {
+				t.Errorf("IntModulus.PowMod(%v, %v) = %v, want %v (mod %v)", tt.a, tt.e, got, tt.want, tt.modulus)
+			}
+		})
+	}
+}
+
+func TestIntModulus_InverseMod(t *testing.T) {
+	tests := []struct {
+		name    string
+		modulus int64
+		a       int64
+		want    int64
+		err     error
+	}{
+		{
+			name:    "Basic test",
+			modulus: 13,
+			a:       5,
+			want:    8,
+		},
+		{
+			name:    "Negative number",
+			modulus: 13,
+			a:       -5,
+			want:    5,
+		},
+		{
+			name:    "Not coprime",
+			modulus: 12,
+			a:       8,
+			err:     modular.ErrNotCoprime,
+		},
+		{
+			name:    "Zero",
+			modulus: 12,
+			a:       0,
+			err:     modular.ErrNotCoprime,
+		},
+		{
+			name:    "Smallest modulus",
+			modulus: math.MinInt64,
+			a:       3,
+			want:    3074457345618258603,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := modular.NewIntModulus(tt.modulus)
+			got, err := m.InverseMod(tt.a)
+			if err != tt.err || got != tt.want {
+				t.Errorf("IntModulus.InverseMod(%v) = %v, %v, want %v, %v (mod %v)", tt.a, got, err, tt.want, tt.err, tt.modulus)
+			}
+		})
+	}
+}
+
+func BenchmarkIntModulus_Ring(b *testing.B) {
+	m := modular.NewIntModulus(intBenchmarkModulo)
+	b.Run("MulMod", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			int64Sink = m.MulMod(int64(i), 123456789)
+		}
+	})
+	b.Run("PowMod", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			int64Sink = m.PowMod(int64(i), uint64(intBenchmarkModulo-2))
+		}
+	})
+	b.Run("InverseMod", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			int64Sink, _ = m.InverseMod(int64(i) + 1)
+		}
+	})
+}
@@ -0,0 +1,14 @@
+package modular32
+
+import (
+	"github.com/stewi1014/modular"
+)
+
+// NewAngleModulus creates a new AngleModulus, which reduces angles in radians by 2π.
+func NewAngleModulus() AngleModulus {
+	return modular.NewAngleModulus[float32]()
+}
+
+// AngleModulus reduces angles in radians by 2π, returning the correctly rounded result.
+// It is an alias of modular.AngleModulus[float32].
+type AngleModulus = modular.AngleModulus[float32]
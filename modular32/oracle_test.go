@@ -0,0 +1,20 @@
+package modular32_test
+
+import (
+	"testing"
+
+	"github.com/stewi1014/modular/modular32"
+	"github.com/stewi1014/modular/modulartest"
+)
+
+func FuzzModulus_Congruent(f *testing.F) {
+	modulartest.FuzzCongruent(f, modular32.NewModulus)
+}
+
+func FuzzModulus_Dist(f *testing.F) {
+	modulartest.FuzzDist(f, modular32.NewModulus)
+}
+
+func FuzzIndexer_Index(f *testing.F) {
+	modulartest.FuzzIndex(f, modular32.NewIndexer)
+}
@@ -1,10 +1,10 @@
+// Package modular64 provides the float64 instantiation of the types in package modular.
+//
+// Every type is an alias, so values can be passed freely between this package and modular.
 package modular64
 
 import (
-	"math"
-	"math/bits"
-
-	"github.com/bmkessler/fastdiv"
+	"github.com/stewi1014/modular"
 )
 
 // NewModulus creates a new Modulus.
@@ -14,118 +14,29 @@ import (
 // Special cases:
 //		NewModulus(0) = panic(integer divide by zero)
 func NewModulus(modulus float64) Modulus {
-	modfr, modexp := frexp(modulus)
-	fd := fastdiv.NewUint64(modfr)
-
-	const minPowerLen = 65
-	powerlen := fMaxExp - modexp
-	if powerlen < minPowerLen {
-		powerlen = minPowerLen
-	}
-
-	powers := make([]uint64, powerlen)
-	r := uint64(1)
-	if len(powers) > 0 {
-		powers[0] = 1
-	}
-	for i := 1; i < len(powers); i++ {
-		r = r << 1
-		r = fd.Mod(r)
-		powers[i] = uint64(r)
-	}
-
-	mod := Modulus{
-		fd:     fastdiv.NewUint64(modfr),
-		powers: powers,
-		mod:    math.Abs(modulus),
-		fr:     modfr,
-		exp:    modexp,
-	}
-
-	return mod
-}
-
-// Modulus defines a modulus.
-// It offers greater performance than traditional floating point modulo calculations by pre-computing the inverse of the modulus's fractional component.
-// This obviously adds overhead to the creation of a new Modulus, but quickly breaks even after a few calls to Congruent.
-type Modulus struct {
-	fd     fastdiv.Uint64
-	powers []uint64
-	mod    float64
-	fr     uint64
-	exp    uint
-}
-
-// Mod returns the modulus.
-func (m Modulus) Mod() float64 {
-	return m.mod
-}
-
-// Dist returns the distance and direction of n1 to n2.
-func (m Modulus) Dist(n1, n2 float64) float64 {
-	d := m.Congruent(n2 - n1)
-	if d > m.mod/2 {
-		return d - m.mod
-	}
-	return d
+	return modular.NewModulus(modulus)
 }
 
-// GetCongruent returns the closest number to n1 that is congruent to n2.
-func (m Modulus) GetCongruent(n1, n2 float64) float64 {
-	return n1 - m.Dist(n2, n1)
+// NewModulusRange creates a new Modulus with a power table only large enough for numbers up to maxAbs in magnitude.
+// Larger numbers give the same results, but are slower.
+//
+// Special cases:
+//		NewModulusRange(0, maxAbs) = panic(integer divide by zero)
+//		NewModulusRange(m, ±Inf) = NewModulus(m)
+//		NewModulusRange(m, NaN) = NewModulus(m)
+func NewModulusRange(modulus, maxAbs float64) Modulus {
+	return modular.NewModulusRange(modulus, maxAbs)
 }
 
-// Congruent returns n mod m.
+// NewModulusTableFree creates a new Modulus without a power table, computing the powers of two it needs by repeated squaring instead.
+// It gives the same results as NewModulus, but is slower for numbers more than a few exponents larger than the modulus.
 //
 // Special cases:
-//		Modulus{NaN}.Congruent(n) = NaN
-// 		Modulus{±Inf}.Congruent(n>=0) = n
-//		Modulus{±Inf}.Congruent(n<0) = +Inf
-//		Modulus{m}.Congruent(±Inf) = NaN
-//		Modulus{m}.Congruent(NaN) = NaN
-func (m Modulus) Congruent(n float64) float64 {
-	if m.mod == 0 || m.mod != m.mod { // 0 or NaN modulus
-		return math.NaN()
-	}
-
-	nfr, nexp := frexp(n)
-
-	if n < m.mod && n > -m.mod {
-		if n < 0 {
-			return n + m.mod
-		}
-		return n
-	}
-
-	if nexp == fMaxExp {
-		return math.NaN()
-	}
-
-	expdiff := nexp - m.exp
-	if m.exp == 0 && nexp != 0 {
-		expdiff-- //We're in denormalised land, skip an exponent.
-	}
-
-	rfr := m.modExp(nfr, expdiff)
-
-	r := ldexp(rfr, m.exp)
-
-	if n < 0 && r != 0 {
-		r = m.mod - r
-	}
-
-	return r
+//		NewModulusTableFree(0) = panic(integer divide by zero)
+func NewModulusTableFree(modulus float64) Modulus {
+	return modular.NewModulusTableFree(modulus)
 }
 
-// modExp returns n * 2**exp (mod m)
-func (m Modulus) modExp(n uint64, exp uint) uint64 {
-	switch { // Switch fastest computation method
-	case exp <= uint(bits.LeadingZeros64(n)):
-		return m.fd.Mod(n << exp)
-
-	default:
-		hi, lo := bits.Mul64(n, m.powers[exp])
-		_, q := bits.Div64(hi, lo, m.fr)
-		return q
-	}
-}
+// Modulus defines a modulus.
+// It is an alias of modular.Modulus[float64].
+type Modulus = modular.Modulus[float64]
@@ -123,14 +123,23 @@ func TestModulus_Congruent(t *testing.T) {
 			want:    math.Mod(-1.370217367318819e-267, 2.039381663448266e-229) + 2.039381663448266e-229,
 		},
 	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			m := modular64.NewModulus(tt.modulus)
-			got := m.Congruent(tt.arg)
-			if got != tt.want && !(math.IsNaN(got) && math.IsNaN(tt.want)) {
-				t.Errorf("Modulus{%v}.Congruent(%v) = %v, want %v", tt.modulus, tt.arg, got, tt.want)
-			}
-		})
+	constructors := []struct {
+		name string
+		new  func(float64) modular64.Modulus
+	}{
+		{"NewModulus", modular64.NewModulus},
+		{"NewModulusTableFree", modular64.NewModulusTableFree},
+	}
+	for _, c := range constructors {
+		for _, tt := range tests {
+			t.Run(c.name+"/"+tt.name, func(t *testing.T) {
+				m := c.new(tt.modulus)
+				got := m.Congruent(tt.arg)
+				if got != tt.want && !(math.IsNaN(got) && math.IsNaN(tt.want)) {
+					t.Errorf("%v(%v).Congruent(%v) = %v, want %v", c.name, tt.modulus, tt.arg, got, tt.want)
+				}
+			})
+		}
 	}
 }
 
@@ -324,6 +333,16 @@ func BenchmarkMath_Mod(b *testing.B) {
 	}
 }
 
+func BenchmarkMath_Remainder(b *testing.B) {
+	for _, n := range benchmarks {
+		b.Run(fmt.Sprintf("Math.Remainder(%v)", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				float64Sink = math.Remainder(n, benchmarkModulo)
+			}
+		})
+	}
+}
+
 func BenchmarkModulus(b *testing.B) {
 	for _, n := range benchmarks {
 		b.Run(fmt.Sprintf("Congruent(%v)", n), func(b *testing.B) {
@@ -334,3 +353,25 @@ func BenchmarkModulus(b *testing.B) {
 		})
 	}
 }
+
+func BenchmarkModulus_Remainder(b *testing.B) {
+	for _, n := range benchmarks {
+		b.Run(fmt.Sprintf("Remainder(%v)", n), func(b *testing.B) {
+			m := modular64.NewModulus(benchmarkModulo)
+			for i := 0; i < b.N; i++ {
+				float64Sink = m.Remainder(n)
+			}
+		})
+	}
+}
+
+func BenchmarkModulus_MulMod(b *testing.B) {
+	for _, n := range benchmarks {
+		b.Run(fmt.Sprintf("MulMod(%v, 3)", n), func(b *testing.B) {
+			m := modular64.NewModulus(benchmarkModulo)
+			for i := 0; i < b.N; i++ {
+				float64Sink = m.MulMod(n, 3)
+			}
+		})
+	}
+}
@@ -0,0 +1,18 @@
+package modular64
+
+import (
+	"github.com/stewi1014/modular"
+)
+
+// TieBreak selects the multiple RoundMultiple picks for a number exactly halfway between two multiples of the modulus.
+// It is an alias of modular.TieBreak.
+type TieBreak = modular.TieBreak
+
+// Tie-breaking rules for RoundMultiple.
+const (
+	TieEven = modular.TieEven // The even multiple, such as 2m for 2.5m.
+	TieAway = modular.TieAway // The multiple further from zero.
+	TieZero = modular.TieZero // The multiple closer to zero.
+	TieUp   = modular.TieUp   // The larger multiple.
+	TieDown = modular.TieDown // The smaller multiple.
+)
@@ -0,0 +1,29 @@
+package modular64
+
+import (
+	"github.com/stewi1014/modular"
+)
+
+// NewRangeModulus creates a new RangeModulus that reduces numbers into [lo, lo+m).
+//
+// Special cases:
+//		NewRangeModulus(0, lo) = panic(integer divide by zero)
+func NewRangeModulus(modulus, lo float64) RangeModulus {
+	return modular.NewRangeModulus(modulus, lo)
+}
+
+// NewSymmetricModulus creates a new RangeModulus that reduces numbers into (-m/2, m/2].
+//
+// Special cases:
+//		NewSymmetricModulus(0) = panic(integer divide by zero)
+func NewSymmetricModulus(modulus float64) RangeModulus {
+	return modular.NewSymmetricModulus(modulus)
+}
+
+// RangeModulus defines a modulus that reduces numbers into a range other than [0, m).
+// It is an alias of modular.RangeModulus[float64].
+type RangeModulus = modular.RangeModulus[float64]
+
+// RangeIndexer maps a RangeModulus's range to a range of integers.
+// It is an alias of modular.RangeIndexer[float64].
+type RangeIndexer = modular.RangeIndexer[float64]
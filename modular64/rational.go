@@ -0,0 +1,18 @@
+package modular64
+
+import (
+	"github.com/stewi1014/modular"
+)
+
+// NewRationalModulus creates a new RationalModulus with the exact modulus p/q.
+//
+// Special cases:
+//		NewRationalModulus(0, q) = panic(integer divide by zero)
+//		NewRationalModulus(p, 0) = panic(integer divide by zero)
+func NewRationalModulus(p, q int64) RationalModulus {
+	return modular.NewRationalModulus[float64](p, q)
+}
+
+// RationalModulus defines a modulus that is an exact fraction p/q.
+// It is an alias of modular.RationalModulus[float64].
+type RationalModulus = modular.RationalModulus[float64]
@@ -0,0 +1,66 @@
+package modulartest
+
+import (
+	"fmt"
+
+	"github.com/stewi1014/modular"
+)
+
+// Congruenter is implemented by moduli that reduce numbers, such as modular.Modulus.
+type Congruenter[T modular.Float] interface {
+	Mod() T
+	Congruent(n T) T
+}
+
+// Distancer is implemented by moduli that find the distance between numbers, such as modular.Modulus.
+type Distancer[T modular.Float] interface {
+	Mod() T
+	Dist(n1, n2 T) T
+}
+
+// Indexer is implemented by types that index numbers, such as modular.Indexer.
+type Indexer[T modular.Float] interface {
+	Mod() T
+	Indexes() int
+	Index(n T) int
+}
+
+// CheckCongruent checks that c.Congruent(n) is the correctly rounded n mod m,
+// returning an error describing the difference if it isn't.
+func CheckCongruent[T modular.Float](c Congruenter[T], n T) error {
+	m := c.Mod()
+	got, want := c.Congruent(n), Congruent(n, m)
+	if !same(got, want) {
+		return fmt.Errorf("%T{%v}.Congruent(%v) = %v, want %v", c, m, n, got, want)
+	}
+	return nil
+}
+
+// CheckDist checks that d.Dist(n1, n2) is the correctly rounded distance of n1 to n2,
+// returning an error describing the difference if it isn't.
+//
+// It accepts the distance computed from either the exact difference n2 - n1 or the rounded one.
+func CheckDist[T modular.Float](d Distancer[T], n1, n2 T) error {
+	m := d.Mod()
+	got := d.Dist(n1, n2)
+	want, rounded := Dist(n1, n2, m), Dist(0, n2-n1, m)
+	if !same(got, want) && !same(got, rounded) {
+		return fmt.Errorf("%T{%v}.Dist(%v, %v) = %v, want %v", d, m, n1, n2, got, want)
+	}
+	return nil
+}
+
+// CheckIndex checks that i.Index(n) is floor(index * (n mod m) / m), where index is i.Indexes(),
+// returning an error describing the difference if it isn't.
+func CheckIndex[T modular.Float](i Indexer[T], n T) error {
+	m, index := i.Mod(), i.Indexes()
+	if got, want := i.Index(n), Index(n, m, index); got != want {
+		return fmt.Errorf("%T{%v, %v}.Index(%v) = %v, want %v", i, m, index, n, got, want)
+	}
+	return nil
+}
+
+// same reports whether x and y are equal or both NaN.
+func same[T modular.Float](x, y T) bool {
+	return x == y || x != x && y != y
+}
@@ -0,0 +1,127 @@
+package modulartest
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stewi1014/modular"
+)
+
+// FuzzCongruent fuzzes the Congruent method of moduli from newModulus, checking each result with CheckCongruent.
+// It is meant to be called from a fuzz target, and seeds the corpus with denormalised numbers, exponent boundaries and non-finite numbers.
+//
+// A modulus of 0 is skipped, as constructors usually panic for it.
+func FuzzCongruent[T modular.Float, C Congruenter[T]](f *testing.F, newModulus func(m T) C) {
+	s := Seeds[T]()
+	for i, m := range s {
+		for _, n := range s[i%3:] {
+			f.Add(m, n)
+		}
+	}
+
+	f.Fuzz(func(t *testing.T, m, n T) {
+		if m == 0 {
+			t.Skip()
+		}
+		if err := CheckCongruent[T](newModulus(m), n); err != nil {
+			t.Error(err)
+		}
+	})
+}
+
+// FuzzDist fuzzes the Dist method of moduli from newModulus, checking each result with CheckDist.
+// It is meant to be called from a fuzz target, and seeds the corpus like FuzzCongruent.
+//
+// A modulus of 0 is skipped, as constructors usually panic for it.
+func FuzzDist[T modular.Float, D Distancer[T]](f *testing.F, newModulus func(m T) D) {
+	s := Seeds[T]()
+	for i, m := range s {
+		f.Add(m, s[(i+1)%len(s)], s[(i+5)%len(s)])
+		f.Add(m, T(0), s[(i+7)%len(s)])
+	}
+
+	f.Fuzz(func(t *testing.T, m, n1, n2 T) {
+		if m == 0 {
+			t.Skip()
+		}
+		if err := CheckDist[T](newModulus(m), n1, n2); err != nil {
+			t.Error(err)
+		}
+	})
+}
+
+// FuzzIndex fuzzes the Index method of indexers from newIndexer, checking each result with CheckIndex.
+// It is meant to be called from a fuzz target, and seeds the corpus like FuzzCongruent.
+//
+// The fuzzed index is between 1 and 2**16, and indexers newIndexer returns an error for are skipped.
+func FuzzIndex[T modular.Float, I Indexer[T]](f *testing.F, newIndexer func(m T, index int) (I, error)) {
+	s := Seeds[T]()
+	indexes := []uint16{0, 1, 2, 9, 359, 1<<16 - 1}
+	for i, m := range s {
+		for j, n := range s {
+			f.Add(m, n, indexes[(i+j)%len(indexes)])
+		}
+	}
+
+	f.Fuzz(func(t *testing.T, m, n T, index uint16) {
+		i, err := newIndexer(m, int(index)+1)
+		if err != nil {
+			t.Skip()
+		}
+		if err := CheckIndex[T](i, n); err != nil {
+			t.Error(err)
+		}
+	})
+}
+
+// Seeds returns numbers that make good test cases for a modular operation over T:
+// zero, denormalised numbers, numbers at exponent and integer boundaries, and ±Inf and NaN, with both signs.
+func Seeds[T modular.Float]() []T {
+	var s []T
+	if _, ok := any(T(0)).(float32); ok {
+		for _, x := range []float32{
+			math.SmallestNonzeroFloat32,
+			math.Float32frombits(1<<23 - 1), // Largest denormalised number
+			0x1p-126,
+			math.Nextafter32(0x1p-126, 1),
+			0.1,
+			math.Nextafter32(1, 0),
+			1,
+			math.Nextafter32(1, 2),
+			3,
+			math.Pi * 2,
+			1 << 24,
+			1<<24 + 2,
+			1 << 63,
+			0x1p64,
+			math.MaxFloat32,
+		} {
+			s = append(s, T(x))
+		}
+	} else {
+		for _, x := range []float64{
+			math.SmallestNonzeroFloat64,
+			math.Float64frombits(1<<52 - 1), // Largest denormalised number
+			0x1p-1022,
+			math.Nextafter(0x1p-1022, 1),
+			0.1,
+			math.Nextafter(1, 0),
+			1,
+			math.Nextafter(1, 2),
+			3,
+			math.Pi * 2,
+			1 << 53,
+			1<<53 + 2,
+			1 << 63,
+			0x1p64,
+			math.MaxFloat64,
+		} {
+			s = append(s, T(x))
+		}
+	}
+
+	for _, x := range s {
+		s = append(s, -x)
+	}
+	return append(s, 0, T(math.Inf(1)), T(math.Inf(-1)), T(math.NaN()))
+}
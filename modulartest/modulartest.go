@@ -0,0 +1,130 @@
+// Package modulartest implements exact reference versions of the modular package's operations,
+// along with checkers and fuzz targets that compare an implementation against them.
+//
+// The reference implementations use big.Rat, so they are slow, but they have no rounding error other than the final rounding to T.
+// They can be used to test the modular package itself, or any wrapper around it.
+package modulartest
+
+import (
+	"math"
+	"math/big"
+
+	"github.com/stewi1014/modular"
+)
+
+// Congruent returns n mod m, computed exactly and rounded to nearest even.
+// The sign of m is ignored.
+//
+// The exact result is always less than m, but as it is rounded the result can equal m,
+// such as for small negative numbers.
+//
+// Special cases:
+//		Congruent(n, 0) = NaN
+//		Congruent(n, NaN) = NaN
+//		Congruent(n>=0, ±Inf) = n
+//		Congruent(n<0, ±Inf) = +Inf
+//		Congruent(±Inf, m) = NaN
+//		Congruent(NaN, m) = NaN
+func Congruent[T modular.Float](n, m T) T {
+	if r, ok := special(n, m); ok {
+		return r
+	}
+	return round[T](CongruentRat(rat(n), rat(m)))
+}
+
+// Dist returns the distance and direction of n1 to n2 modulo m, computed exactly and rounded to nearest even.
+// The result is in (-m/2, m/2], before rounding.
+//
+// The modular package computes n2 - n1 before reducing it, which can round;
+// CheckDist accepts either result.
+//
+// Special cases:
+//		Dist(n1, n2, 0) = NaN
+//		Dist(n1, n2, NaN) = NaN
+//		Dist(n1, n2, ±Inf) = Congruent(n2 - n1, ±Inf)
+//		Dist(±Inf, n2, m) = NaN
+//		Dist(n1, ±Inf, m) = NaN
+//		Dist(NaN, n2, m) = NaN
+//		Dist(n1, NaN, m) = NaN
+func Dist[T modular.Float](n1, n2, m T) T {
+	switch {
+	case isInf(n1) || n1 != n1 || isInf(n2) || n2 != n2 || m == 0 || m != m:
+		return T(math.NaN())
+	case isInf(m):
+		return Congruent(n2-n1, m)
+	}
+
+	bm := new(big.Rat).Abs(rat(m))
+	d := new(big.Rat).Sub(rat(n2), rat(n1))
+	d = CongruentRat(d, bm)
+	if r := new(big.Rat).Sub(bm, d); d.Cmp(r) > 0 {
+		d.Neg(r)
+	}
+	return round[T](d)
+}
+
+// Index returns floor(index * (n mod m) / m), computed exactly.
+// The sign of m is ignored.
+//
+// Like Indexer.Index, it returns the index for anything it can't index.
+//
+// Special cases:
+//		Index(n, 0, index) = index
+//		Index(n, ±Inf, index) = index
+//		Index(n, NaN, index) = index
+//		Index(±Inf, m, index) = index
+//		Index(NaN, m, index) = index
+func Index[T modular.Float](n, m T, index int) int {
+	if isInf(n) || n != n || isInf(m) || m != m || m == 0 {
+		return index
+	}
+	bm := new(big.Rat).Abs(rat(m))
+	r := CongruentRat(rat(n), bm)
+	r.Mul(r, new(big.Rat).SetInt64(int64(index)))
+	r.Quo(r, bm)
+	return int(new(big.Int).Div(r.Num(), r.Denom()).Int64())
+}
+
+// special returns the result of Congruent for a non-finite argument or a zero modulus, and whether there was one.
+func special[T modular.Float](n, m T) (T, bool) {
+	switch {
+	case m == 0 || m != m || isInf(n) || n != n:
+		return T(math.NaN()), true
+	case isInf(m):
+		if n < 0 {
+			return T(math.Inf(1)), true
+		}
+		return n, true
+	}
+	return 0, false
+}
+
+// CongruentRat returns n mod |m| exactly, which is in [0, |m|).
+// It is Congruent without the rounding, for checking operations on exact sums and products that aren't floats.
+func CongruentRat(n, m *big.Rat) *big.Rat {
+	m = new(big.Rat).Abs(m)
+	q := new(big.Rat).Quo(n, m)
+	k := new(big.Int).Div(q.Num(), q.Denom()) // Euclidean, so the floor for a positive denominator.
+	q.SetInt(k)
+	q.Mul(q, m)
+	return q.Sub(n, q)
+}
+
+// rat returns the exact value of a finite x.
+func rat[T modular.Float](x T) *big.Rat {
+	return new(big.Rat).SetFloat64(float64(x))
+}
+
+// round returns the T nearest to r.
+func round[T modular.Float](r *big.Rat) T {
+	if _, ok := any(T(0)).(float32); ok {
+		f, _ := r.Float32()
+		return T(f)
+	}
+	f, _ := r.Float64()
+	return T(f)
+}
+
+func isInf[T modular.Float](x T) bool {
+	return math.IsInf(float64(x), 0)
+}
@@ -0,0 +1,143 @@
+package modulartest_test
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stewi1014/modular"
+	"github.com/stewi1014/modular/modulartest"
+)
+
+func TestCongruent(t *testing.T) {
+	tests := []struct {
+		name string
+		n, m float64
+		want float64
+	}{
+		{name: "Basic test", n: 13, m: 5, want: 3},
+		{name: "Negative number", n: -13, m: 5, want: 2},
+		{name: "Negative modulus", n: -13, m: -5, want: 2},
+		{name: "Exact fraction", n: 1, m: 0.1, want: 0.09999999999999995},
+		{name: "Rounds to the modulus", n: -1e-300, m: 1, want: 1},
+		{name: "Denormalised", n: 3 * math.SmallestNonzeroFloat64, m: 2 * math.SmallestNonzeroFloat64, want: math.SmallestNonzeroFloat64},
+		{name: "Infinite modulus", n: -1, m: math.Inf(1), want: math.Inf(1)},
+		{name: "Infinite number", n: math.Inf(-1), m: 1, want: math.NaN()},
+		{name: "Zero modulus", n: 1, m: 0, want: math.NaN()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := modulartest.Congruent(tt.n, tt.m)
+			if got != tt.want && !(math.IsNaN(got) && math.IsNaN(tt.want)) {
+				t.Errorf("Congruent(%v, %v) = %v, want %v", tt.n, tt.m, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCongruentRat(t *testing.T) {
+	tests := []struct {
+		name string
+		n, m *big.Rat
+		want *big.Rat
+	}{
+		{name: "Basic test", n: big.NewRat(13, 1), m: big.NewRat(5, 1), want: big.NewRat(3, 1)},
+		{name: "Negative number", n: big.NewRat(-1, 3), m: big.NewRat(1, 2), want: big.NewRat(1, 6)},
+		{name: "Negative modulus", n: big.NewRat(7, 10), m: big.NewRat(-1, 5), want: big.NewRat(1, 10)},
+		{name: "Multiple", n: big.NewRat(-3, 2), m: big.NewRat(1, 2), want: new(big.Rat)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := modulartest.CongruentRat(tt.n, tt.m); got.Cmp(tt.want) != 0 {
+				t.Errorf("CongruentRat(%v, %v) = %v, want %v", tt.n, tt.m, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDist(t *testing.T) {
+	tests := []struct {
+		name      string
+		n1, n2, m float32
+		want      float32
+	}{
+		{name: "Forwards", n1: 1, n2: 3, m: 10, want: 2},
+		{name: "Backwards across the modulus", n1: 1, n2: 9, m: 10, want: -2},
+		{name: "Half the modulus", n1: 0, n2: 5, m: 10, want: 5},
+		{name: "Negative half the modulus", n1: 5, n2: 0, m: 10, want: 5},
+		{name: "Exact difference", n1: -math.MaxFloat32, n2: math.MaxFloat32, m: 11, want: -4},
+		{name: "NaN number", n1: float32(math.NaN()), n2: 0, m: 10, want: float32(math.NaN())},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := modulartest.Dist(tt.n1, tt.n2, tt.m)
+			if got != tt.want && !(got != got && tt.want != tt.want) {
+				t.Errorf("Dist(%v, %v, %v) = %v, want %v", tt.n1, tt.n2, tt.m, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIndex(t *testing.T) {
+	tests := []struct {
+		name  string
+		n, m  float64
+		index int
+		want  int
+	}{
+		{name: "Basic test", n: 1, m: 15, index: 15, want: 1},
+		{name: "Negative number", n: -202, m: 200, index: 100, want: 99},
+		{name: "Just below a boundary", n: math.Nextafter(0.5, 0), m: 1, index: 2, want: 0},
+		{name: "On a boundary", n: 0.5, m: 1, index: 2, want: 1},
+		{name: "NaN number", n: math.NaN(), m: 1, index: 7, want: 7},
+		{name: "Negative modulus", n: 1, m: -15, index: 15, want: 1},
+		{name: "Infinite modulus", n: 1, m: math.Inf(1), index: 7, want: 7},
+		{name: "NaN modulus", n: 1, m: math.NaN(), index: 7, want: 7},
+		{name: "Zero modulus", n: 1, m: 0, index: 7, want: 7},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := modulartest.Index(tt.n, tt.m, tt.index); got != tt.want {
+				t.Errorf("Index(%v, %v, %v) = %v, want %v", tt.n, tt.m, tt.index, got, tt.want)
+			}
+		})
+	}
+}
+
+// badModulus rounds towards zero with math.Mod, rather than giving the euclidean modulo.
+type badModulus float64
+
+func (m badModulus) Mod() float64                { return float64(m) }
+func (m badModulus) Congruent(n float64) float64 { return math.Mod(n, float64(m)) }
+func (m badModulus) Dist(n1, n2 float64) float64 { return math.Mod(n2-n1, float64(m)) }
+
+// badIndexer truncates index * n / m towards zero, rather than indexing n mod m.
+type badIndexer float64
+
+func (i badIndexer) Mod() float64        { return float64(i) }
+func (i badIndexer) Indexes() int        { return 10 }
+func (i badIndexer) Index(n float64) int { return int(10 * n / float64(i)) }
+
+func TestCheck(t *testing.T) {
+	m := modular.NewModulus(0.1)
+	if err := modulartest.CheckCongruent[float64](m, -1.05); err != nil {
+		t.Errorf("CheckCongruent(Modulus{0.1}, -1.05) = %v, want nil", err)
+	}
+	if err := modulartest.CheckDist[float64](m, 1e300, -1e300); err != nil {
+		t.Errorf("CheckDist(Modulus{0.1}, 1e300, -1e300) = %v, want nil", err)
+	}
+	i, _ := modular.NewIndexer(0.1, 10)
+	if err := modulartest.CheckIndex[float64](i, -0.35); err != nil {
+		t.Errorf("CheckIndex(Indexer{0.1, 10}, -0.35) = %v, want nil", err)
+	}
+
+	if err := modulartest.CheckCongruent[float64](badModulus(3), -1); err == nil {
+		t.Errorf("CheckCongruent(badModulus{3}, -1) = nil, want an error")
+	}
+	if err := modulartest.CheckDist[float64](badModulus(3), 0, 2); err == nil {
+		t.Errorf("CheckDist(badModulus{3}, 0, 2) = nil, want an error")
+	}
+	if err := modulartest.CheckIndex[float64](badIndexer(0.1), -0.35); err == nil {
+		t.Errorf("CheckIndex(badIndexer{0.1}, -0.35) = nil, want an error")
+	}
+}
@@ -0,0 +1,405 @@
+// Package modular implements fast modular arithmetic over float32 and float64.
+//
+// The modular32 and modular64 packages are thin aliases of the types in this package.
+package modular
+
+import (
+	"math"
+	"math/bits"
+	"unsafe"
+
+	"github.com/bmkessler/fastdiv"
+)
+
+// NewModulus creates a new Modulus.
+//
+// An Infinite modulus has no effect other than to waste CPU time.
+//
+// Special cases:
+//		NewModulus(0) = panic(integer divide by zero)
+func NewModulus[T Float](modulus T) Modulus[T] {
+	if tableFree {
+		return NewModulusTableFree(modulus)
+	}
+
+	_, modexp := frexp(modulus)
+
+	const minPowerLen = 65
+	powerlen := maxExp(unsafe.Sizeof(modulus)) - modexp
+	if powerlen < minPowerLen {
+		powerlen = minPowerLen
+	}
+
+	return newModulus(modulus, powerlen)
+}
+
+// NewModulusRange creates a new Modulus with a power table only large enough for numbers up to maxAbs in magnitude.
+//
+// NewModulus pre-computes a table covering every exponent a float can have, which is up to 16 KiB for float64.
+// If the numbers used are never much larger than the modulus, most of it is never read.
+// Numbers larger than maxAbs are still handled, computing the powers they need as they go.
+// The results are the same as a Modulus from NewModulus, just slower for such numbers.
+//
+// Special cases:
+//		NewModulusRange(0, maxAbs) = panic(integer divide by zero)
+//		NewModulusRange(m, ±Inf) = NewModulus(m)
+//		NewModulusRange(m, NaN) = NewModulus(m)
+func NewModulusRange[T Float](modulus, maxAbs T) Modulus[T] {
+	if maxAbs != maxAbs || isInf(maxAbs) {
+		return NewModulus(modulus)
+	}
+
+	_, modexp := frexp(modulus)
+	_, maxexp := frexp(maxAbs)
+
+	powerlen := uint(1)
+	if maxexp > modexp {
+		powerlen = maxexp - modexp + 1
+	}
+	return newModulus(modulus, powerlen)
+}
+
+// NewModulusTableFree creates a new Modulus without a power table,
+// computing the powers of two it needs by repeated squaring instead.
+//
+// It gives the same results as NewModulus and needs no memory beyond the Modulus itself,
+// but is slower for numbers more than a few exponents larger than the modulus.
+// Building with the modular_notables tag makes NewModulus and NewIndexer create table-free moduli.
+//
+// Special cases:
+//		NewModulusTableFree(0) = panic(integer divide by zero)
+func NewModulusTableFree[T Float](modulus T) Modulus[T] {
+	return newModulus(modulus, 0)
+}
+
+// newModulus creates a new Modulus with a power table of the given length.
+func newModulus[T Float](modulus T, powerlen uint) Modulus[T] {
+	modfr, modexp := frexp(modulus)
+	fd := fastdiv.NewUint64(modfr)
+
+	mod := Modulus[T]{
+		fraction: fraction{
+			fd:  fd,
+			fr:  modfr,
+			exp: modexp,
+		},
+		mod: abs(modulus),
+	}
+
+	switch m := float64(mod.mod); {
+	case modfr&(modfr-1) == 0:
+		powerlen = 0 // Powers of two are reduced by masking, and never need the table
+		if modexp != 0 {
+			mod.special = &pow2Special
+		}
+	case m >= 1 && m < 1<<63 && m == math.Trunc(m):
+		mod.special = &special{
+			kind: integerReducer,
+			ifd:  fastdiv.NewUint64(uint64(m)),
+		}
+	}
+
+	if powerlen > 0 {
+		mod.powers = powersCache.get(fd, modfr, int(powerlen))
+	}
+
+	return mod
+}
+
+// Modulus defines a modulus.
+// It offers greater performance than traditional floating point modulo calculations by pre-computing the inverse of the modulus's fractional component,
+// and pre-computing a lookup table for different exponents in the given modulus, allowing direct computation of n mod m - no iteration or recursion is used.
+// This obviously adds overhead to the creation of a new Modulus, but quickly breaks even after a few calls to Congruent.
+type Modulus[T Float] struct {
+	fraction
+	mod T
+}
+
+// fraction holds the parts of a Modulus that don't depend on the float type;
+// the modulus's fraction and exponent, and the pre-computed values used to reduce by them.
+type fraction struct {
+	fd     fastdiv.Uint64
+	powers []uint64
+	fr     uint64
+	exp    uint
+
+	// The specialised reducer for the modulus, or nil for the general modExp path, chosen once by newModulus.
+	// It is a pointer so that other moduli pay for a single comparison,
+	// and so that the Modulus stays small enough to be passed in registers;
+	// with the integer inverse stored by value, Congruent is about 70% slower for every modulus.
+	special *special
+}
+
+// special holds a specialised reducer for a Modulus.
+type special struct {
+	kind reducer
+	ifd  fastdiv.Uint64 // The inverse of an integer modulus below 2**63, for integerReducer.
+}
+
+// reducer selects a specialised way for a Modulus to reduce numbers larger than itself.
+type reducer uint8
+
+const (
+	pow2Reducer    reducer = iota // Masking the number's fraction, for a normal power of two modulus
+	integerReducer                // Integer division, for an integer modulus below 2**63
+)
+
+// pow2Special is shared by all power of two moduli, which need nothing else.
+var pow2Special = special{kind: pow2Reducer}
+
+// Mod returns the modulus.
+func (m Modulus[T]) Mod() T {
+	return m.mod
+}
+
+// Dist returns the distance and direction of n1 to n2.
+func (m Modulus[T]) Dist(n1, n2 T) T {
+	d := n2 - n1
+	if d < 0 && !isInf(m.mod) {
+		// Reducing -d is exact, where reducing d would round m - r.
+		r := m.Congruent(-d)
+		switch {
+		case r == 0:
+			return 0
+		case r < m.mod-r:
+			return -r
+		}
+		return m.mod - r
+	}
+
+	// Comparing with m - d rather than m/2, which isn't exact for denormalised moduli.
+	d = m.Congruent(d)
+	if d > m.mod-d {
+		return d - m.mod
+	}
+	return d
+}
+
+// GetCongruent returns the closest number to n1 that is congruent to n2.
+func (m Modulus[T]) GetCongruent(n1, n2 T) T {
+	return n1 - m.Dist(n2, n1)
+}
+
+// Remainder returns the IEEE 754 remainder of n / m, n - k*m for the integer k nearest to n/m, with ties to even.
+// It is the same as math.Remainder; the result is exact and in [-m/2, m/2], with the sign of n if it is 0.
+//
+// Special cases:
+//		Modulus{0}.Remainder(n) = NaN
+//		Modulus{NaN}.Remainder(n) = NaN
+//		Modulus{±Inf}.Remainder(n) = n
+//		Modulus{m}.Remainder(±Inf) = NaN
+//		Modulus{m}.Remainder(NaN) = NaN
+func (m Modulus[T]) Remainder(n T) T {
+	switch {
+	case m.mod == 0 || m.mod != m.mod || n != n || isInf(n):
+		return nan[T]()
+	case isInf(m.mod) || n == 0:
+		return n
+	}
+
+	// |n| mod m is exact, and when it is at least m/2, so is m - r.
+	a, r := abs(n), abs(n)
+	if a >= m.mod {
+		r = m.reduce(a)
+	}
+	if d := m.mod - r; r > d || r == d && m.oddQuotient(a) {
+		r = -d
+	}
+	if n < 0 {
+		return -r
+	}
+	return r
+}
+
+// oddQuotient reports whether floor(a / m) is odd for a >= 0.
+func (m Modulus[T]) oddQuotient(a T) bool {
+	_, lo, _, ok := m.quotient(a)
+	return ok && lo&1 != 0
+}
+
+// Congruent returns n mod m.
+//
+// Special cases:
+//		Modulus{NaN}.Congruent(n) = NaN
+// 		Modulus{±Inf}.Congruent(n>=0) = n
+//		Modulus{±Inf}.Congruent(n<0) = +Inf
+//		Modulus{m}.Congruent(±Inf) = NaN
+//		Modulus{m}.Congruent(NaN) = NaN
+func (m Modulus[T]) Congruent(n T) T {
+	if m.mod == 0 || m.mod != m.mod { // 0 or NaN modulus
+		return nan[T]()
+	}
+
+	if n < m.mod && n > -m.mod {
+		if n < 0 {
+			return n + m.mod
+		}
+		return n
+	}
+
+	return m.reduce(n)
+}
+
+// CongruentSlice stores src[i] mod m in dst[i].
+// dst must be at least as long as src, and may be src itself.
+//
+// It gives the same results as calling Congruent on each element, without the per-call overhead.
+func (m Modulus[T]) CongruentSlice(dst, src []T) {
+	dst = dst[:len(src)]
+	mod := m.mod
+	if mod == 0 || mod != mod { // 0 or NaN modulus
+		for i := range dst {
+			dst[i] = nan[T]()
+		}
+		return
+	}
+
+	for i := 0; i < len(src); {
+		i += congruentBatch(dst[i:], src[i:], mod)
+
+		// The batch kernel stops at blocks it can't handle and at the tail, so do a block here.
+		end := i + batchLanes
+		if end > len(src) {
+			end = len(src)
+		}
+		for ; i < end; i++ {
+			n := src[i]
+			if n < mod && n > -mod {
+				if n < 0 {
+					n += mod
+				}
+				dst[i] = n
+				continue
+			}
+			dst[i] = m.reduce(n)
+		}
+	}
+}
+
+// batchLanes is the largest number of elements the batch kernels process at a time.
+const batchLanes = 8
+
+// CongruentInPlace replaces each element of s with s[i] mod m.
+func (m Modulus[T]) CongruentInPlace(s []T) {
+	m.CongruentSlice(s, s)
+}
+
+// reduce returns n mod m for a valid modulus and |n| >= m.
+func (m Modulus[T]) reduce(n T) T {
+	if m.special != nil {
+		if m.special.kind == pow2Reducer {
+			return m.reducePow2(n)
+		}
+		if n < 1<<63 && n > -(1<<63) {
+			return m.reduceInteger(n)
+		}
+	}
+
+	size := unsafe.Sizeof(n)
+	nfr, nexp := frexp(n)
+	if nexp == maxExp(size) {
+		return nan[T]()
+	}
+
+	expdiff := nexp - m.exp
+	if m.exp == 0 && nexp != 0 {
+		expdiff-- //We're in denormalised land, skip an exponent.
+	}
+
+	rfr := m.modExp(nfr, expdiff)
+
+	r := floatFromBits[T](ldexpBits(rfr, m.exp, fractionBits(size)))
+
+	if n < 0 && r != 0 {
+		r = m.mod - r // correctly handle negatives
+	}
+
+	return r
+}
+
+// reduceInteger returns n mod m for an integer modulus and |n| < 2**63.
+func (m Modulus[T]) reduceInteger(n T) T {
+	a := n
+	if a < 0 {
+		a = -a
+	}
+
+	// a mod m = (trunc(a) mod m) + the fraction of a, which is exact as the result is representable.
+	i := uint64(a)
+	r := T(m.special.ifd.Mod(i)) + (a - T(i))
+	if n < 0 && r != 0 {
+		r = m.mod - r
+	}
+	return r
+}
+
+// reducePow2 returns n mod m for a normal power of two modulus and |n| >= m.
+func (m Modulus[T]) reducePow2(n T) T {
+	fracBits := fractionBits(unsafe.Sizeof(n))
+	nbits := absBits(n)
+	nexp := uint(nbits >> fracBits)
+	if nexp == maxExp(unsafe.Sizeof(n)) {
+		return nan[T]()
+	}
+
+	// Clearing the fraction bits worth m or more leaves the multiple of m to subtract, which is exact.
+	var r T
+	if expdiff := nexp - m.exp; expdiff <= fracBits {
+		a := floatFromBits[T](nbits)
+		r = a - floatFromBits[T](nbits&^(1<<(fracBits-expdiff)-1))
+	}
+
+	if n < 0 && r != 0 {
+		r = m.mod - r
+	}
+	return r
+}
+
+// modExp returns n * 2**exp (mod m)
+func (m fraction) modExp(n uint64, exp uint) uint64 {
+	switch { // Switch fastest computation method
+	case exp <= uint(bits.LeadingZeros64(n)):
+		return m.fd.Mod(n << exp)
+
+	case exp < uint(len(m.powers)):
+		hi, lo := bits.Mul64(n, m.powers[exp])
+		_, q := bits.Div64(hi, lo, m.fr)
+		return q
+
+	case m.fr&(m.fr-1) == 0: // Power of two, which has no table
+		// The low bits are correct even if n << exp overflows, and Go's shifts give 0 for exp >= 64.
+		return n << exp & (m.fr - 1)
+
+	default: // Beyond the table of a Modulus from NewModulusRange or NewModulusTableFree
+		hi, lo := bits.Mul64(n, m.pow2(exp))
+		_, q := bits.Div64(hi, lo, m.fr)
+		return q
+	}
+}
+
+// pow2 returns 2**exp (mod m) for exp beyond the power table.
+func (m fraction) pow2(exp uint) uint64 {
+	// Start from the largest power in the table, if there is one.
+	var r uint64
+	if len(m.powers) > 0 {
+		last := uint(len(m.powers) - 1)
+		r, exp = m.powers[last], exp-last
+	}
+
+	// Left to right square-and-multiply; multiplying by 2 is just a shift.
+	p := m.fd.Mod(1)
+	for i := bits.Len(exp) - 1; i >= 0; i-- {
+		hi, lo := bits.Mul64(p, p)
+		_, p = bits.Div64(hi, lo, m.fr)
+		if exp>>uint(i)&1 != 0 {
+			p = m.fd.Mod(p << 1) // p < m.fr < 2**63
+		}
+	}
+
+	if len(m.powers) == 0 {
+		return p
+	}
+	hi, lo := bits.Mul64(r, p)
+	_, r = bits.Div64(hi, lo, m.fr)
+	return r
+}
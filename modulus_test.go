@@ -0,0 +1,616 @@
+package modular_test
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/go-gl/mathgl/mgl32"
+	"github.com/go-gl/mathgl/mgl64"
+	"github.com/stewi1014/modular"
+)
+
+const randomTestNum = 20000
+
+var (
+	float64Sink float64
+)
+
+// randomFloat64 returns a random finite float64 spread evenly across exponents.
+func randomFloat64(r *rand.Rand) float64 {
+	for {
+		f := math.Float64frombits(r.Uint64())
+		if !math.IsNaN(f) && !math.IsInf(f, 0) {
+			return f
+		}
+	}
+}
+
+// randomFloat32 returns a random finite float32 spread evenly across exponents.
+func randomFloat32(r *rand.Rand) float32 {
+	for {
+		f := math.Float32frombits(r.Uint32())
+		if !math.IsNaN(float64(f)) && !math.IsInf(float64(f), 0) {
+			return f
+		}
+	}
+}
+
+// mod64 is a reference implementation of Modulus.Congruent using math.Mod.
+func mod64(n, m float64) float64 {
+	m = math.Abs(m)
+	r := math.Mod(n, m)
+	if r < 0 {
+		return r + m
+	}
+	if r == 0 && n < 0 {
+		return 0
+	}
+	return r
+}
+
+// mod32 is a reference implementation of Modulus.Congruent using math.Mod.
+func mod32(n, m float32) float32 {
+	m = float32(math.Abs(float64(m)))
+	r := float32(math.Mod(float64(n), float64(m)))
+	if r < 0 {
+		return r + m
+	}
+	if r == 0 && n < 0 {
+		return 0
+	}
+	return r
+}
+
+func TestModulus_Congruent(t *testing.T) {
+	tests := []struct {
+		name    string
+		modulus float64
+		arg     float64
+		want    float64
+	}{
+		{
+			name:    "Basic test",
+			modulus: 13,
+			arg:     58,
+			want:    6,
+		},
+		{
+			name:    "Small test",
+			modulus: 0.1,
+			arg:     0.17,
+			want:    0.07,
+		},
+		{
+			name:    "Negative number",
+			modulus: 5,
+			arg:     -34,
+			want:    1,
+		},
+		{
+			name:    "Negative modulo and number",
+			modulus: -5,
+			arg:     -3,
+			want:    2,
+		},
+		{
+			name:    "Negative zero",
+			modulus: 5,
+			arg:     math.Copysign(0, -1),
+			want:    math.Copysign(0, -1),
+		},
+		{
+			name:    "NaN modulus",
+			modulus: math.NaN(),
+			arg:     0,
+			want:    math.NaN(),
+		},
+		{
+			name:    "Inf modulus, negative number",
+			modulus: math.Inf(1),
+			arg:     -1,
+			want:    math.Inf(1),
+		},
+		{
+			name:    "Inf number",
+			modulus: 1,
+			arg:     math.Inf(-1),
+			want:    math.NaN(),
+		},
+		{
+			name:    "Denormalised modulus",
+			modulus: math.Ldexp(1, -1040),
+			arg:     math.Ldexp(3.5, -1040),
+			want:    math.Ldexp(0.5, -1040),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name+" float64", func(t *testing.T) {
+			for _, m := range []modular.Modulus[float64]{modular.NewModulus(tt.modulus), modular.NewModulusTableFree(tt.modulus)} {
+				got := m.Congruent(tt.arg)
+				if math.Float64bits(got) != math.Float64bits(tt.want) && !(math.IsNaN(got) && math.IsNaN(tt.want)) {
+					t.Errorf("Modulus{%v}.Congruent(%v) = %v, want %v", tt.modulus, tt.arg, got, tt.want)
+				}
+			}
+		})
+		if tt.name == "Denormalised modulus" {
+			continue
+		}
+		t.Run(tt.name+" float32", func(t *testing.T) {
+			mod := float32(tt.modulus)
+			for _, m := range []modular.Modulus[float32]{modular.NewModulus(mod), modular.NewModulusTableFree(mod)} {
+				got := m.Congruent(float32(tt.arg))
+				want := float32(tt.want)
+				if math.Float32bits(got) != math.Float32bits(want) && !(got != got && want != want) {
+					t.Errorf("Modulus{%v}.Congruent(%v) = %v, want %v", float32(tt.modulus), float32(tt.arg), got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestModulus_CongruentRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	t.Run("float64", func(t *testing.T) {
+		for i := 0; i < randomTestNum; i++ {
+			mod, n := randomFloat64(r), randomFloat64(r)
+			if mod == 0 {
+				continue
+			}
+			got := modular.NewModulus(mod).Congruent(n)
+			if want := mod64(n, mod); got != want {
+				t.Fatalf("Modulus{%v}.Congruent(%v) = %v, want %v", mod, n, got, want)
+			}
+		}
+	})
+
+	t.Run("float32", func(t *testing.T) {
+		for i := 0; i < randomTestNum; i++ {
+			mod, n := randomFloat32(r), randomFloat32(r)
+			if mod == 0 {
+				continue
+			}
+			got := modular.NewModulus(mod).Congruent(n)
+			if want := mod32(n, mod); got != want {
+				t.Fatalf("Modulus{%v}.Congruent(%v) = %v, want %v", mod, n, got, want)
+			}
+		}
+	})
+}
+
+func TestNewModulusRange(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	t.Run("float64", func(t *testing.T) {
+		for i := 0; i < randomTestNum; i++ {
+			mod, n := randomFloat64(r), randomFloat64(r)
+			if mod == 0 {
+				continue
+			}
+			maxAbs := math.Ldexp(math.Abs(mod), r.Intn(200)-20)
+			got := modular.NewModulusRange(mod, maxAbs).Congruent(n)
+			if want := modular.NewModulus(mod).Congruent(n); got != want {
+				t.Fatalf("NewModulusRange(%v, %v).Congruent(%v) = %v, want %v", mod, maxAbs, n, got, want)
+			}
+		}
+	})
+
+	t.Run("float32", func(t *testing.T) {
+		for i := 0; i < randomTestNum; i++ {
+			mod, n := randomFloat32(r), randomFloat32(r)
+			if mod == 0 {
+				continue
+			}
+			maxAbs := float32(math.Ldexp(math.Abs(float64(mod)), r.Intn(100)-20))
+			got := modular.NewModulusRange(mod, maxAbs).Congruent(n)
+			if want := modular.NewModulus(mod).Congruent(n); got != want {
+				t.Fatalf("NewModulusRange(%v, %v).Congruent(%v) = %v, want %v", mod, maxAbs, n, got, want)
+			}
+		}
+	})
+
+	t.Run("Table free", func(t *testing.T) {
+		for i := 0; i < randomTestNum; i++ {
+			mod, n := randomFloat64(r), randomFloat64(r)
+			if mod == 0 {
+				continue
+			}
+			got := modular.NewModulusTableFree(mod).Congruent(n)
+			if want := mod64(n, mod); got != want {
+				t.Fatalf("NewModulusTableFree(%v).Congruent(%v) = %v, want %v", mod, n, got, want)
+			}
+		}
+	})
+
+	t.Run("Indexer", func(t *testing.T) {
+		for _, n := range []float64{1, -1e5, 1e300, -1e-300} {
+			i, _ := modular.NewModulusRange(0.1, 1).NewIndexer(1000)
+			want, _ := modular.NewIndexer(0.1, 1000)
+			if got, want := i.Index(n), want.Index(n); got != want {
+				t.Errorf("NewModulusRange(0.1, 1).NewIndexer(1000).Index(%v) = %v, want %v", n, got, want)
+			}
+		}
+	})
+}
+
+func TestModulus_CongruentSpecialised(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	moduli := []float64{1, 2, 256, 1024, 0.5, 0x1p-30, 0x1p100, 0x1p-1060, 3, 360, 1e6, 1e15 + 1, 0x1p62 * 1.5}
+
+	for _, mod := range moduli {
+		m64, m32 := modular.NewModulus(mod), modular.NewModulus(float32(math.Max(mod, 0x1p-140)))
+		for i := 0; i < randomTestNum/len(moduli); i++ {
+			n := randomFloat64(r)
+			if i%2 == 0 {
+				n = (r.Float64()*2 - 1) * math.Ldexp(mod, r.Intn(80))
+			}
+
+			if got, want := m64.Congruent(n), mod64(n, mod); got != want {
+				t.Fatalf("Modulus{%v}.Congruent(%v) = %v, want %v", mod, n, got, want)
+			}
+			if got, want := m32.Congruent(float32(n)), mod32(float32(n), m32.Mod()); got != want && !(got != got && want != want) {
+				t.Fatalf("Modulus{%v}.Congruent(%v) = %v, want %v", m32.Mod(), float32(n), got, want)
+			}
+		}
+	}
+}
+
+func TestModulus_Dist(t *testing.T) {
+	tests := []struct {
+		name    string
+		modulus float64
+		n1, n2  float64
+		want    float64
+	}{
+		{
+			name:    "Forwards over 0",
+			modulus: 100,
+			n1:      90,
+			n2:      20,
+			want:    30,
+		},
+		{
+			name:    "Backwards over 0",
+			modulus: 100,
+			n1:      10,
+			n2:      90,
+			want:    -20,
+		},
+		{
+			// Congruent(-1e-30) rounds to the modulus, which would give a distance of 0.
+			name:    "Tiny backwards distance",
+			modulus: 1,
+			n1:      1e-30,
+			n2:      0,
+			want:    -1e-30,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := modular.NewModulus(tt.modulus).Dist(tt.n1, tt.n2); got != tt.want {
+				t.Errorf("Modulus[float64].Dist(%v, %v) = %v, want %v (mod %v)", tt.n1, tt.n2, got, tt.want, tt.modulus)
+			}
+			if got := modular.NewModulus(float32(tt.modulus)).Dist(float32(tt.n1), float32(tt.n2)); got != float32(tt.want) {
+				t.Errorf("Modulus[float32].Dist(%v, %v) = %v, want %v (mod %v)", tt.n1, tt.n2, got, tt.want, tt.modulus)
+			}
+		})
+	}
+
+	// Half of a denormalised modulus of 3 units rounds to 2 units, which is closer backwards.
+	t.Run("Denormalised modulus", func(t *testing.T) {
+		const unit = math.SmallestNonzeroFloat64
+		if got := modular.NewModulus(3*unit).Dist(0, 2*unit); got != -unit {
+			t.Errorf("Modulus[float64].Dist(0, %v) = %v, want %v (mod %v)", 2*unit, got, -unit, 3*unit)
+		}
+		const unit32 = math.SmallestNonzeroFloat32
+		if got := modular.NewModulus(float32(3*unit32)).Dist(0, 2*unit32); got != -unit32 {
+			t.Errorf("Modulus[float32].Dist(0, %v) = %v, want %v (mod %v)", float32(2*unit32), got, float32(-unit32), float32(3*unit32))
+		}
+	})
+}
+
+func TestModulus_Remainder(t *testing.T) {
+	same := func(a, b float64) bool {
+		return math.Float64bits(a) == math.Float64bits(b) || math.IsNaN(a) && math.IsNaN(b)
+	}
+
+	special := []float64{
+		0, math.Copysign(0, -1), 1, -1, 1.5, -2.5, 3, 0.1, -0.35,
+		math.SmallestNonzeroFloat64, 3 * math.SmallestNonzeroFloat64, math.MaxFloat64, -math.MaxFloat64,
+		1e300, math.Inf(1), math.Inf(-1), math.NaN(),
+	}
+	for _, mod := range special {
+		var m modular.Modulus[float64] // The zero Modulus has a modulus of 0.
+		if mod != 0 {
+			m = modular.NewModulusTableFree(mod)
+		}
+		for _, n := range special {
+			if got, want := m.Remainder(n), math.Remainder(n, mod); !same(got, want) {
+				t.Errorf("Modulus{%v}.Remainder(%v) = %v, want %v", mod, n, got, want)
+			}
+		}
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < randomTestNum; i++ {
+		mod, n := randomFloat64(r), randomFloat64(r)
+		if i%2 == 0 {
+			// Ties are rare in random numbers.
+			n = mod * float64(r.Intn(20)-10) / 2
+		}
+		if mod == 0 {
+			continue
+		}
+		if got, want := modular.NewModulus(mod).Remainder(n), math.Remainder(n, mod); !same(got, want) {
+			t.Fatalf("Modulus{%v}.Remainder(%v) = %v, want %v", mod, n, got, want)
+		}
+
+		mod32, n32 := randomFloat32(r), randomFloat32(r)
+		if mod32 == 0 {
+			continue
+		}
+		// The remainder is exact, so float64 arithmetic gives the float32 result.
+		want := float32(math.Remainder(float64(n32), float64(mod32)))
+		if got := modular.NewModulus(mod32).Remainder(n32); !same(float64(got), float64(want)) {
+			t.Fatalf("Modulus{%v}.Remainder(%v) = %v, want %v", mod32, n32, got, want)
+		}
+	}
+}
+
+func TestVecModulus(t *testing.T) {
+	t.Run("Vec2", func(t *testing.T) {
+		m := modular.NewVec2Modulus(mgl64.Vec2{10, 20})
+		got := m.Congruent(mgl64.Vec2{-1, 45})
+		if want := (mgl64.Vec2{9, 5}); got != want {
+			t.Errorf("Vec2Modulus.Congruent() = %v, want %v", got, want)
+		}
+	})
+	t.Run("Vec3", func(t *testing.T) {
+		m := modular.NewVec3Modulus(mgl32.Vec3{10, 20, 30})
+		got := m.GetCongruent(mgl32.Vec3{0, 0, 0}, mgl32.Vec3{19, 21, 29})
+		if want := (mgl32.Vec3{-1, 1, -1}); got != want {
+			t.Errorf("Vec3Modulus.GetCongruent() = %v, want %v", got, want)
+		}
+	})
+	t.Run("Vec4", func(t *testing.T) {
+		m := modular.NewVec4Modulus(mgl64.Vec4{10, 20, 30, 40})
+		got := m.Dist(mgl64.Vec4{0, 0, 0, 0}, mgl64.Vec4{9, 11, 16, 39})
+		if want := (mgl64.Vec4{-1, -9, -14, -1}); got != want {
+			t.Errorf("Vec4Modulus.Dist() = %v, want %v", got, want)
+		}
+	})
+}
+
+func TestModulus_CongruentSlice(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	src := []float64{0, math.Copysign(0, -1), 1, -1, 2.5, -2.5, math.Inf(1), math.Inf(-1), math.NaN()}
+	for len(src) < 1000 {
+		src = append(src, randomFloat64(r))
+	}
+
+	for _, mod := range []float64{2.5, 1e-300, 1e300, math.Inf(1), math.NaN()} {
+		m := modular.NewModulus(mod)
+		dst := make([]float64, len(src))
+		m.CongruentSlice(dst, src)
+
+		inPlace := append([]float64(nil), src...)
+		m.CongruentInPlace(inPlace)
+
+		for i, n := range src {
+			want := m.Congruent(n)
+			if math.Float64bits(dst[i]) != math.Float64bits(want) && !(dst[i] != dst[i] && want != want) {
+				t.Fatalf("Modulus{%v}.CongruentSlice()[%v] = %v, want %v", mod, n, dst[i], want)
+			}
+			if math.Float64bits(inPlace[i]) != math.Float64bits(want) && !(inPlace[i] != inPlace[i] && want != want) {
+				t.Fatalf("Modulus{%v}.CongruentInPlace()[%v] = %v, want %v", mod, n, inPlace[i], want)
+			}
+		}
+	}
+}
+
+// nearFloat64 returns a random number within 2**60 of m, with occasional special values.
+func nearFloat64(r *rand.Rand, m float64) float64 {
+	switch r.Intn(50) {
+	case 0:
+		return math.Copysign(0, -1)
+	case 1:
+		return math.Inf(1 - 2*r.Intn(2))
+	case 2:
+		return math.NaN()
+	case 3:
+		return m * float64(r.Intn(5)-2)
+	}
+	return m * (r.Float64()*2 - 1) * math.Ldexp(1, r.Intn(60))
+}
+
+// TestModulus_CongruentSliceBatch checks that the batch kernels give bit for bit the same results as Congruent.
+func TestModulus_CongruentSliceBatch(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	t.Run("float64", func(t *testing.T) {
+		src, dst := make([]float64, 1003), make([]float64, 1003)
+		for i := 0; i < randomTestNum/100; i++ {
+			mod := math.Abs(randomFloat64(r))
+			if i%10 == 0 {
+				mod = float64(r.Intn(100) + 1)
+			}
+			if mod == 0 {
+				continue
+			}
+			m := modular.NewModulus(mod)
+			for j := range src {
+				src[j] = nearFloat64(r, mod)
+			}
+
+			m.CongruentSlice(dst, src)
+			for j, n := range src {
+				want := m.Congruent(n)
+				if math.Float64bits(dst[j]) != math.Float64bits(want) && !(dst[j] != dst[j] && want != want) {
+					t.Fatalf("Modulus{%v}.CongruentSlice()[%v] = %v, want %v", mod, n, dst[j], want)
+				}
+			}
+		}
+	})
+
+	t.Run("float32", func(t *testing.T) {
+		src, dst := make([]float32, 1003), make([]float32, 1003)
+		for i := 0; i < randomTestNum/100; i++ {
+			mod := float32(math.Abs(float64(randomFloat32(r))))
+			if i%10 == 0 {
+				mod = float32(r.Intn(100) + 1)
+			}
+			if mod == 0 || math.IsInf(float64(mod)*(1<<30), 0) {
+				continue
+			}
+			m := modular.NewModulus(mod)
+			for j := range src {
+				src[j] = float32(nearFloat64(r, float64(mod)) / (1 << 30))
+			}
+
+			m.CongruentSlice(dst, src)
+			for j, n := range src {
+				want := m.Congruent(n)
+				if math.Float32bits(dst[j]) != math.Float32bits(want) && !(dst[j] != dst[j] && want != want) {
+					t.Fatalf("Modulus{%v}.CongruentSlice()[%v] = %v, want %v", mod, n, dst[j], want)
+				}
+			}
+		}
+	})
+}
+
+func TestVecModulus_CongruentSlice(t *testing.T) {
+	t.Run("Vec2", func(t *testing.T) {
+		m := modular.NewVec2Modulus(mgl64.Vec2{10, 20})
+		s := []mgl64.Vec2{{-1, 45}, {3, -3}}
+		m.CongruentInPlace(s)
+		if want := []mgl64.Vec2{{9, 5}, {3, 17}}; s[0] != want[0] || s[1] != want[1] {
+			t.Errorf("Vec2Modulus.CongruentInPlace() = %v, want %v", s, want)
+		}
+	})
+	t.Run("Vec3", func(t *testing.T) {
+		m := modular.NewVec3Modulus(mgl32.Vec3{10, 20, 30})
+		dst := make([]mgl32.Vec3, 1)
+		m.CongruentSlice(dst, []mgl32.Vec3{{-1, 45, 61}})
+		if want := (mgl32.Vec3{9, 5, 1}); dst[0] != want {
+			t.Errorf("Vec3Modulus.CongruentSlice() = %v, want %v", dst, want)
+		}
+	})
+	t.Run("Vec4", func(t *testing.T) {
+		m := modular.NewVec4Modulus(mgl64.Vec4{10, 20, 30, 40})
+		dst := make([]mgl64.Vec4, 1)
+		m.CongruentSlice(dst, []mgl64.Vec4{{-1, 45, 61, -41}})
+		if want := (mgl64.Vec4{9, 5, 1, 39}); dst[0] != want {
+			t.Errorf("Vec4Modulus.CongruentSlice() = %v, want %v", dst, want)
+		}
+	})
+}
+
+var benchmarkModulo = float64(1e-25)
+var benchmarks = []float64{
+	0,
+	2.5e-25,
+	1,
+	1e300,
+}
+
+func BenchmarkModulus(b *testing.B) {
+	for _, n := range benchmarks {
+		b.Run(fmt.Sprintf("Congruent(%v)", n), func(b *testing.B) {
+			m := modular.NewModulus(benchmarkModulo)
+			for i := 0; i < b.N; i++ {
+				float64Sink = m.Congruent(n)
+			}
+		})
+	}
+}
+
+func BenchmarkModulus_Dist(b *testing.B) {
+	for _, n := range benchmarks {
+		b.Run(fmt.Sprintf("Dist(%v, 0)", n), func(b *testing.B) {
+			m := modular.NewModulus(benchmarkModulo)
+			for i := 0; i < b.N; i++ {
+				float64Sink = m.Dist(n, 0)
+			}
+		})
+	}
+}
+
+func BenchmarkModulusRange(b *testing.B) {
+	for _, n := range benchmarks {
+		b.Run(fmt.Sprintf("Congruent(%v)", n), func(b *testing.B) {
+			m := modular.NewModulusRange(benchmarkModulo, 1e-20)
+			for i := 0; i < b.N; i++ {
+				float64Sink = m.Congruent(n)
+			}
+		})
+	}
+}
+
+func BenchmarkModulusTableFree(b *testing.B) {
+	for _, n := range benchmarks {
+		b.Run(fmt.Sprintf("Congruent(%v)", n), func(b *testing.B) {
+			m := modular.NewModulusTableFree(benchmarkModulo)
+			for i := 0; i < b.N; i++ {
+				float64Sink = m.Congruent(n)
+			}
+		})
+	}
+}
+
+func BenchmarkModulus_Specialised(b *testing.B) {
+	moduli := []struct {
+		name string
+		mod  float64
+	}{
+		{"Power of two", 1024},
+		{"Integer", 360},
+		{"General", 359.9},
+		{"Denormalised power of two", 0x1p-1040}, // Reduced by masking on the general path
+	}
+	for _, m := range moduli {
+		for _, n := range []float64{1234.5, 1e15, 1e300} {
+			b.Run(fmt.Sprintf("%v/Congruent(%v)", m.name, n), func(b *testing.B) {
+				mod := modular.NewModulus(m.mod)
+				for i := 0; i < b.N; i++ {
+					float64Sink = mod.Congruent(n)
+				}
+			})
+		}
+	}
+	for _, m := range moduli[:3] { // Indexers need a normalised modulus
+		for _, n := range []float64{1234.5, 1e15, 1e300} {
+			b.Run(fmt.Sprintf("%v/Index(%v)", m.name, n), func(b *testing.B) {
+				ind, _ := modular.NewIndexer(m.mod, 1000)
+				for i := 0; i < b.N; i++ {
+					intSink = ind.Index(n)
+				}
+			})
+		}
+	}
+}
+
+func BenchmarkModulus_CongruentSlice(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	src, dst := make([]float64, 1024), make([]float64, 1024)
+	for i := range src {
+		src[i] = (r.Float64() - 0.5) * 3
+	}
+	m := modular.NewModulus(1.0)
+
+	b.Run("Congruent", func(b *testing.B) {
+		b.SetBytes(int64(len(src) * 8))
+		for i := 0; i < b.N; i++ {
+			for j, n := range src {
+				dst[j] = m.Congruent(n)
+			}
+		}
+	})
+	b.Run("CongruentSlice", func(b *testing.B) {
+		b.SetBytes(int64(len(src) * 8))
+		for i := 0; i < b.N; i++ {
+			m.CongruentSlice(dst, src)
+		}
+	})
+}
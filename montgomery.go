@@ -0,0 +1,128 @@
+package modular
+
+import (
+	"math/bits"
+)
+
+// NewMontgomeryModulus creates a new MontgomeryModulus.
+//
+// Special cases:
+//		NewMontgomeryModulus(0) = panic(integer divide by zero)
+//		NewMontgomeryModulus(m) = ErrBadModulo for even m
+func NewMontgomeryModulus[T Integer](modulus T) (MontgomeryModulus[T], error) {
+	mod := NewIntModulus(modulus)
+	return mod.NewMontgomery()
+}
+
+// NewMontgomery creates a new MontgomeryModulus from the IntModulus.
+func (m IntModulus[T]) NewMontgomery() (MontgomeryModulus[T], error) {
+	if m.mod&1 == 0 {
+		return MontgomeryModulus[T]{}, ErrBadModulo
+	}
+
+	// Newton's iteration doubles the correct bits each step, and m is its own inverse mod 8.
+	inv := m.mod
+	for i := 0; i < 5; i++ {
+		inv *= 2 - m.mod*inv
+	}
+
+	one := m.fd.Mod(m.fd.Mod(^uint64(0)) + 1) // 2**64 mod m
+	return MontgomeryModulus[T]{
+		IntModulus: m,
+		ninv:       -inv,
+		one:        one,
+		r2:         m.mul(one, one),
+	}, nil
+}
+
+// MontgomeryModulus defines an odd integer modulus with multiplication in Montgomery form.
+// Numbers are converted into Montgomery form with ToMont, after which MulMont and PowMont need neither division nor fastdiv's wide multiplications.
+// This suits long chains of multiplications, where the cost of converting in and out is spread over many operations.
+//
+// Numbers in Montgomery form always satisfy 0 <= n < |m|.
+// Passing other values to the *Mont methods gives undefined results.
+type MontgomeryModulus[T Integer] struct {
+	IntModulus[T]
+	ninv uint64 // -m**-1 mod 2**64
+	one  uint64 // 2**64 mod m; 1 in Montgomery form
+	r2   uint64 // 2**128 mod m
+}
+
+// ToMont converts n into Montgomery form.
+func (m MontgomeryModulus[T]) ToMont(n T) T {
+	return T(m.mulMont(m.congruent(n), m.r2))
+}
+
+// FromMont converts n out of Montgomery form.
+// The result always satisfies 0 <= r < |m|.
+func (m MontgomeryModulus[T]) FromMont(n T) T {
+	return T(m.redc(0, uint64(n)))
+}
+
+// MulMont returns a * b (mod m) for a and b in Montgomery form.
+// The result is in Montgomery form.
+func (m MontgomeryModulus[T]) MulMont(a, b T) T {
+	return T(m.mulMont(uint64(a), uint64(b)))
+}
+
+// PowMont returns a**e (mod m) for a in Montgomery form.
+// The result is in Montgomery form.
+//
+// Special cases:
+//		PowMont(a, 0) = ToMont(1)
+func (m MontgomeryModulus[T]) PowMont(a T, e uint64) T {
+	r, x := m.one, uint64(a)
+	for ; e != 0; e >>= 1 {
+		if e&1 != 0 {
+			r = m.mulMont(r, x)
+		}
+		x = m.mulMont(x, x)
+	}
+	return T(r)
+}
+
+// ToMontSlice converts each element of src into Montgomery form, storing them in dst.
+// dst must be at least as long as src, and may be src itself.
+func (m MontgomeryModulus[T]) ToMontSlice(dst, src []T) {
+	dst = dst[:len(src)]
+	for i, n := range src {
+		dst[i] = T(m.mulMont(m.congruent(n), m.r2))
+	}
+}
+
+// FromMontSlice converts each element of src out of Montgomery form, storing them in dst.
+// dst must be at least as long as src, and may be src itself.
+func (m MontgomeryModulus[T]) FromMontSlice(dst, src []T) {
+	dst = dst[:len(src)]
+	for i, n := range src {
+		dst[i] = T(m.redc(0, uint64(n)))
+	}
+}
+
+// MulMontSlice stores a[i] * b[i] (mod m) in dst[i], for a and b in Montgomery form.
+// b and dst must be at least as long as a, and dst may be a or b itself.
+func (m MontgomeryModulus[T]) MulMontSlice(dst, a, b []T) {
+	dst, b = dst[:len(a)], b[:len(a)]
+	for i := range a {
+		dst[i] = T(m.mulMont(uint64(a[i]), uint64(b[i])))
+	}
+}
+
+// mulMont returns a * b * 2**-64 (mod m) for a and b already reduced.
+func (m MontgomeryModulus[T]) mulMont(a, b uint64) uint64 {
+	hi, lo := bits.Mul64(a, b)
+	return m.redc(hi, lo)
+}
+
+// redc returns (hi, lo) * 2**-64 (mod m) for hi < m.
+func (m MontgomeryModulus[T]) redc(hi, lo uint64) uint64 {
+	// Adding q*m clears the low word, leaving a number below 2m in the high word and carry.
+	q := lo * m.ninv
+	qhi, qlo := bits.Mul64(q, m.mod)
+	_, carry := bits.Add64(lo, qlo, 0)
+	r, carry := bits.Add64(hi, qhi, carry)
+	if carry != 0 || r >= m.mod {
+		r -= m.mod
+	}
+	return r
+}
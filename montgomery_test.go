@@ -0,0 +1,135 @@
+package modular_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stewi1014/modular"
+)
+
+func TestNewMontgomeryModulus(t *testing.T) {
+	tests := []struct {
+		name    string
+		modulus int64
+		err     error
+	}{
+		{
+			name:    "Odd modulus",
+			modulus: 1e9 + 7,
+		},
+		{
+			name:    "Negative modulus",
+			modulus: -13,
+		},
+		{
+			name:    "Modulus of one",
+			modulus: 1,
+		},
+		{
+			name:    "Even modulus",
+			modulus: 1 << 20,
+			err:     modular.ErrBadModulo,
+		},
+		{
+			name:    "Smallest modulus",
+			modulus: math.MinInt64,
+			err:     modular.ErrBadModulo,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := modular.NewMontgomeryModulus(tt.modulus); err != tt.err {
+				t.Errorf("NewMontgomeryModulus(%v) error = %v, want %v", tt.modulus, err, tt.err)
+			}
+		})
+	}
+}
+
+// testMontgomery checks that m gives the same results as the plain IntModulus it is built on.
+func testMontgomery[T modular.Integer](t *testing.T, m modular.MontgomeryModulus[T], a, b T, e uint64) {
+	t.Helper()
+	ma, mb := m.ToMont(a), m.ToMont(b)
+	if got, want := m.FromMont(ma), m.Congruent(a); got != want {
+		t.Fatalf("MontgomeryModulus{%v}.FromMont(ToMont(%v)) = %v, want %v", m.Mod(), a, got, want)
+	}
+	if got, want := m.FromMont(m.MulMont(ma, mb)), m.MulMod(a, b); got != want {
+		t.Fatalf("MontgomeryModulus{%v}.MulMont(%v, %v) = %v, want %v", m.Mod(), a, b, got, want)
+	}
+	if got, want := m.FromMont(m.PowMont(ma, e)), m.PowMod(a, e); got != want {
+		t.Fatalf("MontgomeryModulus{%v}.PowMont(%v, %v) = %v, want %v", m.Mod(), a, e, got, want)
+	}
+}
+
+func TestMontgomeryModulus_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	t.Run("int64", func(t *testing.T) {
+		for i := 0; i < randomTestNum; i++ {
+			mod := int64(r.Uint64()>>uint(r.Intn(64))) | 1
+			m, err := modular.NewMontgomeryModulus(mod)
+			if err != nil {
+				t.Fatalf("NewMontgomeryModulus(%v) error = %v", mod, err)
+			}
+			testMontgomery(t, m, int64(r.Uint64()), int64(r.Uint64()), r.Uint64()>>uint(r.Intn(64)))
+		}
+	})
+
+	t.Run("uint64", func(t *testing.T) {
+		for i := 0; i < randomTestNum; i++ {
+			mod := r.Uint64()>>uint(r.Intn(64)) | 1
+			m, err := modular.NewMontgomeryModulus(mod)
+			if err != nil {
+				t.Fatalf("NewMontgomeryModulus(%v) error = %v", mod, err)
+			}
+			testMontgomery(t, m, r.Uint64(), r.Uint64(), r.Uint64()>>uint(r.Intn(64)))
+		}
+	})
+
+	t.Run("Largest modulus", func(t *testing.T) {
+		m, _ := modular.NewMontgomeryModulus(uint64(math.MaxUint64))
+		testMontgomery(t, m, math.MaxUint64-1, math.MaxUint64-2, math.MaxUint64)
+	})
+}
+
+func TestMontgomeryModulus_Slice(t *testing.T) {
+	m, _ := modular.NewMontgomeryModulus(int64(1e9 + 7))
+	a := []int64{1, -2, 3e9, math.MinInt64}
+	b := []int64{5, 6, -7e12, math.MaxInt64}
+
+	ma, mb := make([]int64, len(a)), make([]int64, len(b))
+	m.ToMontSlice(ma, a)
+	m.ToMontSlice(mb, b)
+	m.MulMontSlice(ma, ma, mb)
+	m.FromMontSlice(ma, ma)
+
+	for i := range a {
+		if want := m.MulMod(a[i], b[i]); ma[i] != want {
+			t.Errorf("MontgomeryModulus.MulMontSlice()[%v] = %v, want %v", i, ma[i], want)
+		}
+	}
+}
+
+func BenchmarkMontgomeryModulus(b *testing.B) {
+	m, _ := modular.NewMontgomeryModulus(intBenchmarkModulo)
+	b.Run("MulMont", func(b *testing.B) {
+		x, y := m.ToMont(12345), m.ToMont(123456789)
+		for i := 0; i < b.N; i++ {
+			x = m.MulMont(x, y)
+		}
+		int64Sink = x
+	})
+	b.Run("MulMod", func(b *testing.B) {
+		x := int64(12345)
+		for i := 0; i < b.N; i++ {
+			x = m.MulMod(x, 123456789)
+		}
+		int64Sink = x
+	})
+	b.Run("PowMont", func(b *testing.B) {
+		x := m.ToMont(12345)
+		for i := 0; i < b.N; i++ {
+			int64Sink = m.PowMont(x, uint64(intBenchmarkModulo-2))
+		}
+	})
+}
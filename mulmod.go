@@ -0,0 +1,131 @@
+package modular
+
+import (
+	"math/bits"
+	"unsafe"
+)
+
+// MulMod returns (a * b) mod m.
+//
+// The product is computed exactly, reduced, and rounded once, to nearest,
+// so unlike Congruent(a * b), it is correct when the product is much larger than the modulus, or overflows.
+// Like Congruent, a product just below a multiple of m can round to m.
+//
+// Special cases:
+//		Modulus{NaN}.MulMod(a, b) = NaN
+//		Modulus{±Inf}.MulMod(a, b) = Congruent(a * b)
+//		Modulus{m}.MulMod(±Inf, b) = NaN
+//		Modulus{m}.MulMod(a, ±Inf) = NaN
+//		Modulus{m}.MulMod(NaN, b) = NaN
+//		Modulus{m}.MulMod(a, NaN) = NaN
+func (m Modulus[T]) MulMod(a, b T) T {
+	switch {
+	case m.mod == 0 || m.mod != m.mod || a != a || b != b || isInf(a) || isInf(b):
+		return nan[T]()
+	case isInf(m.mod):
+		return m.Congruent(a * b)
+	}
+
+	afr, aexp := floatParts(a)
+	bfr, bexp := floatParts(b)
+	hi, lo := bits.Mul64(afr, bfr)
+	return m.mulMod(hi, lo, aexp+bexp, (a < 0) != (b < 0))
+}
+
+// MulIntMod returns (k * step) mod m, such as the phase after k steps.
+//
+// Like MulMod, the product is computed exactly, and rounded once.
+//
+// Special cases:
+//		Modulus{NaN}.MulIntMod(k, step) = NaN
+//		Modulus{±Inf}.MulIntMod(k, step) = Congruent(k * step)
+//		Modulus{m}.MulIntMod(k, ±Inf) = NaN
+//		Modulus{m}.MulIntMod(k, NaN) = NaN
+func (m Modulus[T]) MulIntMod(k int64, step T) T {
+	switch {
+	case m.mod == 0 || m.mod != m.mod || step != step || isInf(step):
+		return nan[T]()
+	case isInf(m.mod):
+		return m.Congruent(T(k) * step)
+	}
+
+	uk := uint64(k)
+	if k < 0 {
+		uk = -uk
+	}
+	sfr, sexp := floatParts(step)
+	hi, lo := bits.Mul64(uk, sfr)
+	return m.mulMod(hi, lo, sexp, (k < 0) != (step < 0))
+}
+
+// mulMod returns hi, lo * 2**exp mod m, or -(hi, lo * 2**exp) mod m if neg is true, rounded to nearest.
+// m must be finite and non-zero.
+func (m Modulus[T]) mulMod(hi, lo uint64, exp int, neg bool) (r T) {
+	size := unsafe.Sizeof(r)
+	mfr, mexp := m.fr, int(m.exp)
+	if mexp == 0 {
+		mexp = 1 // Denormalised numbers share the smallest exponent.
+	}
+	mexp -= int(maxExp(size)/2) + int(fractionBits(size))
+
+	// The result is w * 2**exp, plus a fraction below its last bit if sticky is true.
+	var w [3]uint64
+	var sticky bool
+	if d := exp - mexp; d >= 0 {
+		// The product is a whole number of the modulus's units, so the result is exact.
+		_, rem := bits.Div64(hi%mfr, lo, mfr)
+		rem = m.modExp(rem, uint(d))
+		if neg && rem != 0 {
+			rem = mfr - rem
+		}
+		w, exp = [3]uint64{0, 0, rem}, mexp
+	} else {
+		// The product's bits below the modulus's last bit are kept as they are; the rest is reduced.
+		s := uint(-d)
+		w = [3]uint64{0, hi, lo}
+		if qhi, qlo := shr128(hi, lo, s); qhi|qlo != 0 {
+			_, rem := bits.Div64(qhi%mfr, qlo, mfr)
+			hi, lo = low128(hi, lo, s)
+			w = shl192([3]uint64{0, 0, rem}, s)
+			w[1] |= hi
+			w[2] |= lo
+		}
+
+		if neg && w != [3]uint64{} {
+			if s <= 128 {
+				w, _ = sub192(shl192([3]uint64{0, 0, mfr}, s), w)
+			} else {
+				// m is fr * 2**s units, which is too many bits; drop s - 128 of them from both.
+				// w is less than 2**128 units, so it's only the number, not a remainder.
+				t := s - 128
+				qhi, qlo := shr128(w[1], w[2], t)
+				lhi, llo := low128(w[1], w[2], t)
+				sticky = lhi|llo != 0
+				w, _ = sub192([3]uint64{mfr, 0, 0}, [3]uint64{0, qhi, qlo})
+				if sticky {
+					w, _ = sub192(w, [3]uint64{0, 0, 1})
+				}
+				exp += int(t)
+			}
+		}
+	}
+
+	if w == [3]uint64{} {
+		return 0
+	}
+	z := leadingZeros192(w)
+	w = shl192(w, z)
+	// w's top word is 128 bits above its bottom bit.
+	return floatFromBits[T](roundBits(w[0], exp+128-int(z), sticky || w[1]|w[2] != 0, size))
+}
+
+// low128 returns the bottom s bits of hi, lo.
+func low128(hi, lo uint64, s uint) (uint64, uint64) {
+	switch {
+	case s >= 128:
+		return hi, lo
+	case s >= 64:
+		return hi & (1<<(s-64) - 1), lo
+	}
+	return 0, lo & (1<<s - 1)
+}
@@ -0,0 +1,143 @@
+package modular_test
+
+import (
+	"math"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/stewi1014/modular"
+	"github.com/stewi1014/modular/modulartest"
+)
+
+func TestModulus_MulMod(t *testing.T) {
+	tests := []struct {
+		name    string
+		modulus float64
+		a, b    float64
+		want    float64
+	}{
+		{
+			name:    "Basic test",
+			modulus: 10,
+			a:       7, b: 5,
+			want: 5,
+		},
+		{
+			name:    "Large product",
+			modulus: 1,
+			a:       123456789012345, b: 0.1,
+			want: 0.500685322848539,
+		},
+		{
+			name:    "Negative product",
+			modulus: 1,
+			a:       123456789012345, b: -0.1,
+			want: 0.49931467715146105,
+		},
+		{
+			name:    "Overflowing product",
+			modulus: 7,
+			a:       1e300, b: 1e300,
+			want: 1,
+		},
+		{
+			name:    "Underflowing product",
+			modulus: 1,
+			a:       -1e-300, b: 1e-300,
+			want: 1,
+		},
+		{
+			name:    "Denormalised modulus",
+			modulus: 2 * math.SmallestNonzeroFloat64,
+			a:       3, b: -math.SmallestNonzeroFloat64,
+			want: math.SmallestNonzeroFloat64,
+		},
+		{
+			name:    "Zero",
+			modulus: 3,
+			a:       0, b: -5,
+			want: 0,
+		},
+		{
+			name:    "Infinite modulus",
+			modulus: math.Inf(1),
+			a:       -1, b: 2,
+			want: math.Inf(1),
+		},
+		{
+			name:    "Infinite number",
+			modulus: 1,
+			a:       math.Inf(1), b: 1,
+			want: math.NaN(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := modular.NewModulus(tt.modulus)
+			if got := m.MulMod(tt.a, tt.b); got != tt.want && !(math.IsNaN(got) && math.IsNaN(tt.want)) {
+				t.Errorf("Modulus{%v}.MulMod(%v, %v) = %v, want %v", tt.modulus, tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestModulus_MulIntMod(t *testing.T) {
+	tests := []struct {
+		name    string
+		modulus float64
+		k       int64
+		step    float64
+		want    float64
+	}{
+		{name: "Basic test", modulus: 360, k: 7, step: 100, want: 340},
+		{name: "Many steps", modulus: 1, k: 123456789012345, step: 0.1, want: 0.500685322848539},
+		{name: "Negative steps", modulus: 1, k: -123456789012345, step: 0.1, want: 0.49931467715146105},
+		{name: "Smallest int64", modulus: 3, k: math.MinInt64, step: 1, want: 1},
+		{name: "NaN step", modulus: 3, k: 1, step: math.NaN(), want: math.NaN()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := modular.NewModulus(tt.modulus)
+			if got := m.MulIntMod(tt.k, tt.step); got != tt.want && !(math.IsNaN(got) && math.IsNaN(tt.want)) {
+				t.Errorf("Modulus{%v}.MulIntMod(%v, %v) = %v, want %v", tt.modulus, tt.k, tt.step, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestModulus_MulModRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < randomTestNum/4; i++ {
+		mod64 := math.Abs(randomFloat64(r))
+		a64, b64 := randomFloat64(r), randomFloat64(r)
+		k := int64(r.Uint64()) >> uint(r.Intn(64))
+		mod32 := float32(math.Abs(float64(randomFloat32(r))))
+		a32, b32 := randomFloat32(r), randomFloat32(r)
+		if mod64 == 0 || mod32 == 0 {
+			continue
+		}
+
+		m64 := modular.NewModulus(mod64)
+		bm := new(big.Rat).SetFloat64(mod64)
+		ba, bb := new(big.Rat).SetFloat64(a64), new(big.Rat).SetFloat64(b64)
+		if want, _ := modulartest.CongruentRat(new(big.Rat).Mul(ba, bb), bm).Float64(); m64.MulMod(a64, b64) != want {
+			t.Fatalf("Modulus{%v}.MulMod(%v, %v) = %v, want %v", mod64, a64, b64, m64.MulMod(a64, b64), want)
+		}
+		bk := new(big.Rat).SetInt64(k)
+		if want, _ := modulartest.CongruentRat(bk.Mul(bk, bb), bm).Float64(); m64.MulIntMod(k, b64) != want {
+			t.Fatalf("Modulus{%v}.MulIntMod(%v, %v) = %v, want %v", mod64, k, b64, m64.MulIntMod(k, b64), want)
+		}
+
+		m32 := modular.NewModulus(mod32)
+		bm = new(big.Rat).SetFloat64(float64(mod32))
+		ba, bb = new(big.Rat).SetFloat64(float64(a32)), new(big.Rat).SetFloat64(float64(b32))
+		if want, _ := modulartest.CongruentRat(new(big.Rat).Mul(ba, bb), bm).Float32(); m32.MulMod(a32, b32) != want {
+			t.Fatalf("Modulus{%v}.MulMod(%v, %v) = %v, want %v", mod32, a32, b32, m32.MulMod(a32, b32), want)
+		}
+		bk = new(big.Rat).SetInt64(k)
+		if want, _ := modulartest.CongruentRat(bk.Mul(bk, bb), bm).Float32(); m32.MulIntMod(k, b32) != want {
+			t.Fatalf("Modulus{%v}.MulIntMod(%v, %v) = %v, want %v", mod32, k, b32, m32.MulIntMod(k, b32), want)
+		}
+	}
+}
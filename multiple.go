@@ -0,0 +1,158 @@
+package modular
+
+import (
+	"math/bits"
+	"unsafe"
+)
+
+// TieBreak selects the multiple RoundMultiple picks for a number exactly halfway between two multiples of the modulus.
+type TieBreak uint8
+
+const (
+	TieEven TieBreak = iota // The even multiple, such as 2m for 2.5m.
+	TieAway                 // The multiple further from zero.
+	TieZero                 // The multiple closer to zero.
+	TieUp                   // The larger multiple.
+	TieDown                 // The smaller multiple.
+)
+
+// away reports whether a tie between the multiples k*m and (k+1)*m of a number with the given sign rounds to (k+1)*m.
+func (t TieBreak) away(k uint64, neg bool) bool {
+	switch t {
+	case TieAway:
+		return true
+	case TieUp:
+		return !neg
+	case TieDown:
+		return neg
+	case TieZero:
+		return false
+	}
+	return k&1 != 0
+}
+
+// FloorMultiple returns the largest multiple of m not greater than n, floor(n / m) * m.
+//
+// The multiple is computed exactly, then rounded to nearest, so it is consistent with Congruent;
+// n - Congruent(n) is the same multiple, but rounded twice.
+// For numbers much larger than the modulus, the nearest float to the multiple is n itself.
+//
+// Special cases:
+//		Modulus{NaN}.FloorMultiple(n) = NaN
+//		Modulus{±Inf}.FloorMultiple(n>=0) = 0
+//		Modulus{±Inf}.FloorMultiple(n<0) = -Inf
+//		Modulus{m}.FloorMultiple(±Inf) = ±Inf
+//		Modulus{m}.FloorMultiple(NaN) = NaN
+func (m Modulus[T]) FloorMultiple(n T) T {
+	if r, ok := m.multipleSpecial(n); ok {
+		return r
+	}
+	hi, lo, rem, ok := m.quotient(n)
+	if !ok {
+		return n
+	}
+	return m.multiple(hi, lo, n < 0 && rem != 0, n < 0)
+}
+
+// CeilMultiple returns the smallest multiple of m not less than n, ceil(n / m) * m.
+//
+// Like FloorMultiple, the multiple is computed exactly and then rounded to nearest.
+//
+// Special cases:
+//		Modulus{NaN}.CeilMultiple(n) = NaN
+//		Modulus{±Inf}.CeilMultiple(n>0) = +Inf
+//		Modulus{±Inf}.CeilMultiple(n<=0) = 0
+//		Modulus{m}.CeilMultiple(±Inf) = ±Inf
+//		Modulus{m}.CeilMultiple(NaN) = NaN
+func (m Modulus[T]) CeilMultiple(n T) T {
+	if r, ok := m.multipleSpecial(n); ok {
+		return r
+	}
+	hi, lo, rem, ok := m.quotient(n)
+	if !ok {
+		return n
+	}
+	return m.multiple(hi, lo, n > 0 && rem != 0, n < 0)
+}
+
+// RoundMultiple returns the multiple of m nearest to n, breaking ties with tie.
+//
+// Like FloorMultiple, the multiple is computed exactly and then rounded to nearest.
+//
+// Special cases:
+//		Modulus{NaN}.RoundMultiple(n, tie) = NaN
+//		Modulus{±Inf}.RoundMultiple(n, tie) = 0
+//		Modulus{m}.RoundMultiple(±Inf, tie) = ±Inf
+//		Modulus{m}.RoundMultiple(NaN, tie) = NaN
+func (m Modulus[T]) RoundMultiple(n T, tie TieBreak) T {
+	if r, ok := m.multipleSpecial(n); ok {
+		return r
+	}
+	hi, lo, rem, ok := m.quotient(n)
+	if !ok {
+		return n
+	}
+
+	// Compare the remainder with the other half of the modulus.
+	var over, half bool
+	if hi|lo == 0 {
+		// |n| < m, where m - |n| is exact or at least larger than |n|.
+		a := abs(n)
+		over, half = a > m.mod-a, a == m.mod-a
+	} else {
+		over, half = rem > m.fr-rem, rem == m.fr-rem
+	}
+	return m.multiple(hi, lo, over || half && tie.away(lo, n < 0), n < 0)
+}
+
+// multipleSpecial returns the multiple of m for NaN and ±Inf numbers and moduli, and whether there was one.
+func (m Modulus[T]) multipleSpecial(n T) (T, bool) {
+	switch {
+	case m.mod == 0 || m.mod != m.mod || n != n: // 0 or NaN modulus
+		return nan[T](), true
+	case isInf(n):
+		return n, true
+	}
+	return 0, false
+}
+
+// multiple returns hi, lo * m, or the next multiple if next is true, rounded to nearest and negated if neg is true.
+func (m Modulus[T]) multiple(hi, lo uint64, next, neg bool) (r T) {
+	if next {
+		var c uint64
+		lo, c = bits.Add64(lo, 1, 0)
+		hi += c
+	}
+
+	size := unsafe.Sizeof(r)
+	switch {
+	case hi|lo == 0:
+	case isInf(m.mod):
+		r = m.mod
+	default:
+		// k * fr, which the quotient's range keeps to at most 128 bits, plus 1 for the next multiple.
+		h0, l0 := bits.Mul64(lo, m.fr)
+		h1, l1 := bits.Mul64(hi, m.fr)
+		w1, c := bits.Add64(h0, l1, 0)
+		w := [3]uint64{h1 + c, w1, l0}
+
+		z := leadingZeros192(w)
+		w = shl192(w, z)
+		// The modulus is fr * 2**(mexp - bias - fractionBits), and w's top word is 128 bits above its bottom bit.
+		mexp := m.exp
+		if mexp == 0 {
+			mexp = 1 // Denormalised numbers share the smallest exponent.
+		}
+		exp := int(mexp) - int(maxExp(size)/2) - int(fractionBits(size)) + 128 - int(z)
+		if exp+63+int(maxExp(size)/2) >= int(maxExp(size)) {
+			r = floatFromBits[T](uint64(maxExp(size)) << fractionBits(size))
+		} else {
+			r = floatFromBits[T](roundBits(w[0], exp, w[1]|w[2] != 0, size))
+		}
+	}
+
+	if neg {
+		return -r
+	}
+	return r
+}
@@ -0,0 +1,209 @@
+package modular_test
+
+import (
+	"math"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/go-gl/mathgl/mgl64"
+	"github.com/stewi1014/modular"
+)
+
+func TestModulus_Multiple(t *testing.T) {
+	tests := []struct {
+		name             string
+		modulus, arg     float64
+		floor, ceil      float64
+		round, roundAway float64
+	}{
+		{
+			name:    "Basic test",
+			modulus: 10, arg: 23,
+			floor: 20, ceil: 30,
+			round: 20, roundAway: 20,
+		},
+		{
+			name:    "Negative number",
+			modulus: 10, arg: -23,
+			floor: -30, ceil: -20,
+			round: -20, roundAway: -20,
+		},
+		{
+			name:    "Tie",
+			modulus: 10, arg: 25,
+			floor: 20, ceil: 30,
+			round: 20, roundAway: 30,
+		},
+		{
+			name:    "Negative tie",
+			modulus: 10, arg: -15,
+			floor: -20, ceil: -10,
+			round: -20, roundAway: -20,
+		},
+		{
+			name:    "Tie below the modulus",
+			modulus: 3, arg: 1.5,
+			floor: 0, ceil: 3,
+			round: 0, roundAway: 3,
+		},
+		{
+			name:    "Multiple",
+			modulus: 0.5, arg: -1.5,
+			floor: -1.5, ceil: -1.5,
+			round: -1.5, roundAway: -1.5,
+		},
+		{
+			name:    "Fractional modulus",
+			modulus: 0.1, arg: 1,
+			floor: 0.9, ceil: 1,
+			round: 1, roundAway: 1,
+		},
+		{
+			name:    "Large number",
+			modulus: 3, arg: 1e300,
+			floor: 1e300, ceil: 1e300,
+			round: 1e300, roundAway: 1e300,
+		},
+		{
+			name:    "Overflow",
+			modulus: 0x1.8p1023, arg: 0x1.9p1023,
+			floor: 0x1.8p1023, ceil: math.Inf(1),
+			round: 0x1.8p1023, roundAway: 0x1.8p1023,
+		},
+		{
+			name:    "Denormalised modulus",
+			modulus: 3 * math.SmallestNonzeroFloat64, arg: 100 * math.SmallestNonzeroFloat64,
+			floor: 99 * math.SmallestNonzeroFloat64, ceil: 102 * math.SmallestNonzeroFloat64,
+			round: 99 * math.SmallestNonzeroFloat64, roundAway: 99 * math.SmallestNonzeroFloat64,
+		},
+		{
+			name:    "Infinite modulus",
+			modulus: math.Inf(1), arg: -1,
+			floor: math.Inf(-1), ceil: 0,
+			round: 0, roundAway: 0,
+		},
+		{
+			name:    "Infinite number",
+			modulus: 1, arg: math.Inf(-1),
+			floor: math.Inf(-1), ceil: math.Inf(-1),
+			round: math.Inf(-1), roundAway: math.Inf(-1),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := modular.NewModulus(tt.modulus)
+			if got := m.FloorMultiple(tt.arg); got != tt.floor {
+				t.Errorf("Modulus{%v}.FloorMultiple(%v) = %v, want %v", tt.modulus, tt.arg, got, tt.floor)
+			}
+			if got := m.CeilMultiple(tt.arg); got != tt.ceil {
+				t.Errorf("Modulus{%v}.CeilMultiple(%v) = %v, want %v", tt.modulus, tt.arg, got, tt.ceil)
+			}
+			if got := m.RoundMultiple(tt.arg, modular.TieEven); got != tt.round {
+				t.Errorf("Modulus{%v}.RoundMultiple(%v, TieEven) = %v, want %v", tt.modulus, tt.arg, got, tt.round)
+			}
+			if got := m.RoundMultiple(tt.arg, modular.TieAway); got != tt.roundAway {
+				t.Errorf("Modulus{%v}.RoundMultiple(%v, TieAway) = %v, want %v", tt.modulus, tt.arg, got, tt.roundAway)
+			}
+		})
+	}
+}
+
+func TestModulus_RoundMultipleTies(t *testing.T) {
+	m := modular.NewModulus(2.0)
+	tests := []struct {
+		tie      modular.TieBreak
+		pos, neg float64
+	}{
+		{tie: modular.TieEven, pos: 4, neg: -4},
+		{tie: modular.TieAway, pos: 4, neg: -4},
+		{tie: modular.TieZero, pos: 2, neg: -2},
+		{tie: modular.TieUp, pos: 4, neg: -2},
+		{tie: modular.TieDown, pos: 2, neg: -4},
+	}
+	for _, tt := range tests {
+		if got := m.RoundMultiple(3, tt.tie); got != tt.pos {
+			t.Errorf("Modulus{2}.RoundMultiple(3, %v) = %v, want %v", tt.tie, got, tt.pos)
+		}
+		if got := m.RoundMultiple(-3, tt.tie); got != tt.neg {
+			t.Errorf("Modulus{2}.RoundMultiple(-3, %v) = %v, want %v", tt.tie, got, tt.neg)
+		}
+	}
+}
+
+// bigMultiple returns the multiple k*m for floor(n/m), ceil(n/m) and round(n/m) with ties to even.
+func bigMultiple(n, m float64) (floor, ceil, round *big.Rat) {
+	bm := new(big.Rat).SetFloat64(math.Abs(m))
+	q := new(big.Rat).Quo(new(big.Rat).SetFloat64(n), bm)
+	k := new(big.Int).Div(q.Num(), q.Denom())
+	frac := new(big.Rat).Sub(q, new(big.Rat).SetInt(k))
+
+	floor = new(big.Rat).SetInt(k)
+	ceil = new(big.Rat).Set(floor)
+	if frac.Sign() != 0 {
+		ceil.Add(ceil, big.NewRat(1, 1))
+	}
+	round = new(big.Rat).Set(floor)
+	if c := frac.Cmp(big.NewRat(1, 2)); c > 0 || c == 0 && k.Bit(0) != 0 {
+		round.Add(round, big.NewRat(1, 1))
+	}
+	return floor.Mul(floor, bm), ceil.Mul(ceil, bm), round.Mul(round, bm)
+}
+
+func TestModulus_MultipleRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < randomTestNum/4; i++ {
+		mod64 := math.Ldexp(r.Float64()+0.5, r.Intn(2100)-1080)
+		n64 := math.Ldexp(r.Float64()*2-1, r.Intn(2100)-1080)
+		mod32, n32 := randomFloat32(r), randomFloat32(r)
+		if math.IsInf(mod64, 0) || math.IsInf(n64, 0) || mod64 == 0 || mod32 == 0 {
+			continue
+		}
+
+		m64 := modular.NewModulus(mod64)
+		floor, ceil, round := bigMultiple(n64, mod64)
+		for _, c := range []struct {
+			name string
+			got  float64
+			want *big.Rat
+		}{
+			{"FloorMultiple", m64.FloorMultiple(n64), floor},
+			{"CeilMultiple", m64.CeilMultiple(n64), ceil},
+			{"RoundMultiple", m64.RoundMultiple(n64, modular.TieEven), round},
+		} {
+			if want, _ := c.want.Float64(); c.got != want {
+				t.Fatalf("Modulus{%v}.%v(%v) = %v, want %v", mod64, c.name, n64, c.got, want)
+			}
+		}
+
+		m32 := modular.NewModulus(mod32)
+		floor, ceil, round = bigMultiple(float64(n32), float64(mod32))
+		for _, c := range []struct {
+			name string
+			got  float32
+			want *big.Rat
+		}{
+			{"FloorMultiple", m32.FloorMultiple(n32), floor},
+			{"CeilMultiple", m32.CeilMultiple(n32), ceil},
+			{"RoundMultiple", m32.RoundMultiple(n32, modular.TieEven), round},
+		} {
+			if want, _ := c.want.Float32(); c.got != want {
+				t.Fatalf("Modulus{%v}.%v(%v) = %v, want %v", mod32, c.name, n32, c.got, want)
+			}
+		}
+	}
+}
+
+func TestVecModulus_Multiple(t *testing.T) {
+	m := modular.NewVec3Modulus(mgl64.Vec3{1, 2, 0.5})
+	vec := mgl64.Vec3{1.5, -3, 0.25}
+	if got, want := m.FloorMultiple(vec), (mgl64.Vec3{1, -4, 0}); got != want {
+		t.Errorf("Vec3Modulus.FloorMultiple(%v) = %v, want %v", vec, got, want)
+	}
+	if got, want := m.CeilMultiple(vec), (mgl64.Vec3{2, -2, 0.5}); got != want {
+		t.Errorf("Vec3Modulus.CeilMultiple(%v) = %v, want %v", vec, got, want)
+	}
+	if got, want := m.RoundMultiple(vec, modular.TieUp), (mgl64.Vec3{2, -2, 0.5}); got != want {
+		t.Errorf("Vec3Modulus.RoundMultiple(%v, TieUp) = %v, want %v", vec, got, want)
+	}
+}
@@ -0,0 +1,7 @@
+//go:build modular_notables
+
+package modular
+
+// tableFree makes NewModulus create moduli without power tables, as NewModulusTableFree does.
+// It is set by the modular_notables build tag.
+const tableFree = true
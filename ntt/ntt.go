@@ -0,0 +1,176 @@
+// Package ntt implements the number-theoretic transform over prime integer moduli.
+//
+// The transform is the discrete Fourier transform over the integers mod p,
+// allowing exact convolution and polynomial multiplication of residues.
+// The input is reduced once, after which the butterflies add and subtract with a conditional correction,
+// and multiply by the roots of unity in Montgomery form, so the transform uses no division instructions;
+// only New does, to find and convert the roots.
+package ntt
+
+import (
+	"errors"
+	"math/bits"
+
+	"github.com/stewi1014/modular"
+)
+
+// Error types
+var (
+	ErrBadLength = errors.New("bad transform length")
+)
+
+// New creates a new Transform of length n over the prime p.
+//
+// n must be a power of two that divides p-1.
+//
+// Special cases:
+//		New(p, n) = modular.ErrBadModulo if p is not prime
+//		New(p, n) = ErrBadLength if n is not a power of two or doesn't divide p-1
+func New(p uint64, n int) (Transform, error) {
+	if !IsPrime(p) {
+		return Transform{}, modular.ErrBadModulo
+	}
+	if n < 1 || n&(n-1) != 0 || (p-1)%uint64(n) != 0 {
+		return Transform{}, ErrBadLength
+	}
+
+	im := modular.NewIntModulus(p)
+	m, err := im.NewMontgomery()
+	if err != nil {
+		// p is 2, where the only length is 1, which needs no roots of unity.
+		m = modular.MontgomeryModulus[uint64]{IntModulus: im}
+	}
+
+	// g**((p-1)/n) is a principal n'th root of unity for any quadratic non-residue g,
+	// as its n/2'th power is g**((p-1)/2) = -1; unlike a primitive root, this doesn't need p-1 factored.
+	w := uint64(1)
+	if n > 1 {
+		w = im.PowMod(nonResidue(im), (p-1)/uint64(n))
+	}
+	winv, _ := im.InverseMod(w)
+	ninv, _ := im.InverseMod(uint64(n))
+
+	t := Transform{
+		m:     m,
+		n:     n,
+		roots: make([]uint64, n/2),
+		inv:   make([]uint64, n/2),
+	}
+	r, rinv := uint64(1), uint64(1)
+	for i := range t.roots {
+		t.roots[i], t.inv[i] = m.ToMont(r), m.ToMont(rinv)
+		r, rinv = im.MulMod(r, w), im.MulMod(rinv, winv)
+	}
+	if n > 1 {
+		t.ninv = m.ToMont(ninv)
+	}
+	return t, nil
+}
+
+// Transform is a number-theoretic transform of a fixed length over a prime modulus.
+type Transform struct {
+	m     modular.MontgomeryModulus[uint64]
+	n     int
+	roots []uint64 // powers of the principal n'th root of unity, in Montgomery form
+	inv   []uint64 // powers of its inverse, in Montgomery form
+	ninv  uint64   // n**-1 mod p, in Montgomery form
+}
+
+// Len returns the length of the transform.
+func (t Transform) Len() int {
+	return t.n
+}
+
+// Mod returns the prime modulus of the transform.
+func (t Transform) Mod() uint64 {
+	return t.m.Mod()
+}
+
+// Forward transforms a in place.
+// a must have the transform's length, and the result is in natural order.
+func (t Transform) Forward(a []uint64) {
+	t.transform(a, t.roots)
+}
+
+// Inverse inverts Forward in place.
+// a must have the transform's length.
+func (t Transform) Inverse(a []uint64) {
+	t.transform(a, t.inv)
+	if t.n == 1 {
+		return
+	}
+	for i := range a {
+		a[i] = t.m.MulMont(a[i], t.ninv)
+	}
+}
+
+// transform performs an iterative radix-2 Cooley-Tukey transform using the given powers of the root of unity.
+func (t Transform) transform(a []uint64, roots []uint64) {
+	n := t.n
+	if len(a) != n {
+		panic("ntt: slice length doesn't match transform length")
+	}
+	if n == 1 {
+		a[0] = t.m.Congruent(a[0])
+		return
+	}
+
+	shift := 64 - uint(bits.TrailingZeros(uint(n)))
+	for i := range a {
+		a[i] = t.m.Congruent(a[i])
+		if j := int(bits.Reverse64(uint64(i)) >> shift); i < j {
+			a[i], a[j] = a[j], a[i]
+		}
+	}
+
+	// Everything is reduced from here on, so the butterflies needn't reduce their inputs again.
+	// Multiplying by a root in Montgomery form leaves the product in ordinary form.
+	for half := 1; half < n; half <<= 1 {
+		stride := n / (half * 2)
+		for start := 0; start < n; start += half * 2 {
+			for j := 0; j < half; j++ {
+				u, v := a[start+j], t.m.MulMont(a[start+j+half], roots[j*stride])
+				a[start+j], a[start+j+half] = t.m.AddReduced(u, v), t.m.SubReduced(u, v)
+			}
+		}
+	}
+}
+
+// Convolve returns the linear convolution of a and b mod p; the coefficients of the product of the polynomials they describe.
+// The result has length len(a)+len(b)-1, which rounded up to a power of two must divide p-1.
+//
+// Special cases:
+//		Convolve(p, a, b) = nil if a or b is empty
+//		Convolve(p, a, b) = modular.ErrBadModulo if p is not prime
+//		Convolve(p, a, b) = ErrBadLength if p-1 is not divisible by the transform length
+func Convolve(p uint64, a, b []uint64) ([]uint64, error) {
+	if len(a) == 0 || len(b) == 0 {
+		return nil, nil
+	}
+
+	l := len(a) + len(b) - 1
+	n := 1
+	for n < l {
+		n <<= 1
+	}
+
+	t, err := New(p, n)
+	if err != nil {
+		return nil, err
+	}
+	if n == 1 {
+		// A transform of length 1 is the identity.
+		return []uint64{t.m.MulMod(a[0], b[0])}, nil
+	}
+
+	fa, fb := make([]uint64, n), make([]uint64, n)
+	copy(fa, a)
+	copy(fb, b)
+	t.Forward(fa)
+	t.Forward(fb)
+	for i := range fa {
+		fa[i] = t.m.MulMont(fa[i], t.m.ToMont(fb[i]))
+	}
+	t.Inverse(fa)
+	return fa[:l], nil
+}
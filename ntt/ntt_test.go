@@ -0,0 +1,214 @@
+package ntt_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stewi1014/modular"
+	"github.com/stewi1014/modular/ntt"
+)
+
+const (
+	nttPrime        = 998244353 // 119 * 2**23 + 1
+	goldilocksPrime = 1<<64 - 1<<32 + 1
+	safePrime       = 4611686018427394499 // 2q + 1 for a prime q, so p-1 is slow to factor
+)
+
+// naiveConvolve returns the convolution of a and b mod p by the schoolbook method.
+func naiveConvolve(p uint64, a, b []uint64) []uint64 {
+	m := modular.NewIntModulus(p)
+	r := make([]uint64, len(a)+len(b)-1)
+	for i := range a {
+		for j := range b {
+			r[i+j] = m.AddMod(r[i+j], m.MulMod(a[i], b[j]))
+		}
+	}
+	return r
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name string
+		p    uint64
+		n    int
+		err  error
+	}{
+		{
+			name: "NTT prime",
+			p:    nttPrime,
+			n:    1 << 10,
+		},
+		{
+			name: "Length of one",
+			p:    nttPrime,
+			n:    1,
+		},
+		{
+			name: "Not a power of two",
+			p:    nttPrime,
+			n:    7,
+			err:  ntt.ErrBadLength,
+		},
+		{
+			name: "Doesn't divide p-1",
+			p:    nttPrime,
+			n:    1 << 24,
+			err:  ntt.ErrBadLength,
+		},
+		{
+			name: "Safe prime",
+			p:    safePrime,
+			n:    2,
+		},
+		{
+			name: "Safe prime, doesn't divide p-1",
+			p:    safePrime,
+			n:    4,
+			err:  ntt.ErrBadLength,
+		},
+		{
+			name: "Not prime",
+			p:    nttPrime + 2,
+			n:    1 << 10,
+			err:  modular.ErrBadModulo,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ntt.New(tt.p, tt.n); err != tt.err {
+				t.Errorf("New(%v, %v) error = %v, want %v", tt.p, tt.n, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestTransform_Inverse(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for _, p := range []uint64{nttPrime, goldilocksPrime, safePrime} {
+		for n := 1; n <= 1<<10 && (p-1)%uint64(n) == 0; n <<= 1 {
+			tr, err := ntt.New(p, n)
+			if err != nil {
+				t.Fatalf("New(%v, %v) error = %v", p, n, err)
+			}
+
+			a, want := make([]uint64, n), make([]uint64, n)
+			for i := range a {
+				a[i] = r.Uint64() % p
+			}
+			copy(want, a)
+
+			tr.Forward(a)
+			tr.Inverse(a)
+			for i := range a {
+				if a[i] != want[i] {
+					t.Fatalf("Inverse(Forward(a))[%v] = %v, want %v (p = %v, n = %v)", i, a[i], want[i], p, n)
+				}
+			}
+		}
+	}
+}
+
+func TestTransform_Forward(t *testing.T) {
+	// The transform of a delta at 1 is the powers of the root of unity, which must cycle with period n.
+	tr, _ := ntt.New(nttPrime, 8)
+	a := []uint64{0, 1, 0, 0, 0, 0, 0, 0}
+	tr.Forward(a)
+
+	m := modular.NewIntModulus(uint64(nttPrime))
+	if a[0] != 1 || m.PowMod(a[1], 8) != 1 || m.PowMod(a[1], 4) == 1 {
+		t.Errorf("Forward(delta) = %v, want powers of a principal 8th root of unity", a)
+	}
+	for i := 2; i < len(a); i++ {
+		if want := m.MulMod(a[i-1], a[1]); a[i] != want {
+			t.Errorf("Forward(delta)[%v] = %v, want %v", i, a[i], want)
+		}
+	}
+}
+
+func TestConvolve(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for _, p := range []uint64{nttPrime, goldilocksPrime} {
+		for i := 0; i < 50; i++ {
+			a, b := make([]uint64, 1+r.Intn(100)), make([]uint64, 1+r.Intn(100))
+			for j := range a {
+				a[j] = r.Uint64()
+			}
+			for j := range b {
+				b[j] = r.Uint64()
+			}
+
+			got, err := ntt.Convolve(p, a, b)
+			if err != nil {
+				t.Fatalf("Convolve(%v, a, b) error = %v", p, err)
+			}
+			want := naiveConvolve(p, a, b)
+			if len(got) != len(want) {
+				t.Fatalf("len(Convolve(%v, a, b)) = %v, want %v", p, len(got), len(want))
+			}
+			for j := range got {
+				if got[j] != want[j] {
+					t.Fatalf("Convolve(%v, a, b)[%v] = %v, want %v", p, j, got[j], want[j])
+				}
+			}
+		}
+	}
+
+	t.Run("Empty", func(t *testing.T) {
+		if got, err := ntt.Convolve(nttPrime, nil, []uint64{1}); got != nil || err != nil {
+			t.Errorf("Convolve(p, nil, [1]) = %v, %v, want nil, nil", got, err)
+		}
+	})
+}
+
+func TestPrimitiveRoot(t *testing.T) {
+	tests := []struct {
+		name string
+		p    uint64
+		want uint64
+		err  error
+	}{
+		{name: "Two", p: 2, want: 1},
+		{name: "Small prime", p: 7, want: 3},
+		{name: "NTT prime", p: nttPrime, want: 3},
+		{name: "Goldilocks prime", p: goldilocksPrime, want: 7},
+		{name: "Largest prime", p: 1<<64 - 59, want: 2},
+		{name: "One", p: 1, err: modular.ErrBadModulo},
+		{name: "Carmichael number", p: 561, err: modular.ErrBadModulo},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ntt.PrimitiveRoot(tt.p)
+			if got != tt.want || err != tt.err {
+				t.Errorf("PrimitiveRoot(%v) = %v, %v, want %v, %v", tt.p, got, err, tt.want, tt.err)
+			}
+		})
+	}
+}
+
+func TestIsPrime(t *testing.T) {
+	for n := uint64(0); n < 1000; n++ {
+		want := n >= 2
+		for f := uint64(2); f*f <= n; f++ {
+			want = want && n%f != 0
+		}
+		if got := ntt.IsPrime(n); got != want {
+			t.Errorf("IsPrime(%v) = %v, want %v", n, got, want)
+		}
+	}
+	// Strong pseudoprime to bases 2 through 37 would slip past a smaller base set.
+	if ntt.IsPrime(3825123056546413051) {
+		t.Errorf("IsPrime(3825123056546413051) = true, want false")
+	}
+}
+
+func BenchmarkTransform(b *testing.B) {
+	tr, _ := ntt.New(nttPrime, 1<<12)
+	a := make([]uint64, tr.Len())
+	for i := range a {
+		a[i] = uint64(i)
+	}
+	b.SetBytes(int64(len(a) * 8))
+	for i := 0; i < b.N; i++ {
+		tr.Forward(a)
+	}
+}
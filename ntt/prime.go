@@ -0,0 +1,98 @@
+package ntt
+
+import (
+	"github.com/stewi1014/modular"
+)
+
+// PrimitiveRoot returns the smallest primitive root of the prime p; a generator of the multiplicative group mod p.
+//
+// p-1 is factored by trial division, which is fast for NTT-friendly primes, where p-1 is a large power of two times a small number.
+//
+// Special cases:
+//		PrimitiveRoot(p) = modular.ErrBadModulo if p is not prime
+func PrimitiveRoot(p uint64) (uint64, error) {
+	if !IsPrime(p) {
+		return 0, modular.ErrBadModulo
+	}
+
+	m := modular.NewIntModulus(p)
+	factors := primeFactors(p - 1)
+	for g := uint64(1); g < p; g++ {
+		ok := true
+		for _, f := range factors {
+			if m.PowMod(g, (p-1)/f) == 1 {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			return g, nil
+		}
+	}
+	panic("ntt: prime without a primitive root")
+}
+
+// nonResidue returns the smallest quadratic non-residue mod the odd prime m;
+// the smallest g with g**((m-1)/2) != 1 (mod m).
+func nonResidue(m modular.IntModulus[uint64]) uint64 {
+	p := m.Mod()
+	g := uint64(2)
+	for m.PowMod(g, (p-1)/2) == 1 {
+		g++
+	}
+	return g
+}
+
+// IsPrime reports whether n is prime.
+// It uses a Miller-Rabin test with a set of bases that is deterministic for all 64 bit numbers.
+func IsPrime(n uint64) bool {
+	if n < 2 {
+		return false
+	}
+	bases := [...]uint64{2, 3, 5, 7, 11, 13, 17, 19, 23, 29, 31, 37}
+	for _, b := range bases {
+		if n%b == 0 {
+			return n == b
+		}
+	}
+
+	d, s := n-1, 0
+	for d&1 == 0 {
+		d >>= 1
+		s++
+	}
+
+	m := modular.NewIntModulus(n)
+	for _, b := range bases {
+		x := m.PowMod(b, d)
+		if x == 1 || x == n-1 {
+			continue
+		}
+		composite := true
+		for i := 1; i < s && composite; i++ {
+			x = m.MulMod(x, x)
+			composite = x != n-1
+		}
+		if composite {
+			return false
+		}
+	}
+	return true
+}
+
+// primeFactors returns the distinct prime factors of n.
+func primeFactors(n uint64) []uint64 {
+	var factors []uint64
+	for f := uint64(2); f <= n/f; f++ {
+		if n%f == 0 {
+			factors = append(factors, f)
+			for n%f == 0 {
+				n /= f
+			}
+		}
+	}
+	if n > 1 {
+		factors = append(factors, n)
+	}
+	return factors
+}
@@ -0,0 +1,59 @@
+package modular_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stewi1014/modular"
+	"github.com/stewi1014/modular/modulartest"
+)
+
+func TestModulus_Oracle(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < randomTestNum/10; i++ {
+		m64, n64, d64 := randomFloat64(r), randomFloat64(r), randomFloat64(r)
+		m32, n32, d32 := randomFloat32(r), randomFloat32(r), randomFloat32(r)
+		if m64 == 0 || m32 == 0 {
+			continue
+		}
+
+		for _, err := range []error{
+			modulartest.CheckCongruent[float64](modular.NewModulus(m64), n64),
+			modulartest.CheckCongruent[float64](modular.NewModulusTableFree(m64), n64),
+			modulartest.CheckCongruent[float64](modular.NewModulusRange(m64, m64*16), n64),
+			modulartest.CheckDist[float64](modular.NewModulus(m64), n64, d64),
+			modulartest.CheckCongruent[float32](modular.NewModulus(m32), n32),
+			modulartest.CheckCongruent[float32](modular.NewModulusTableFree(m32), n32),
+			modulartest.CheckCongruent[float32](modular.NewModulusRange(m32, m32*16), n32),
+			modulartest.CheckDist[float32](modular.NewModulus(m32), n32, d32),
+		} {
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		index := r.Intn(1<<16) + 1
+		if i, err := modular.NewIndexer(m64, index); err == nil {
+			if err := modulartest.CheckIndex[float64](i, n64); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if i, err := modular.NewIndexer(m32, index); err == nil {
+			if err := modulartest.CheckIndex[float32](i, n32); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+}
+
+func FuzzModulus_Congruent(f *testing.F) {
+	modulartest.FuzzCongruent(f, modular.NewModulus[float64])
+}
+
+func FuzzModulus_Dist(f *testing.F) {
+	modulartest.FuzzDist(f, modular.NewModulus[float64])
+}
+
+func FuzzIndexer_Index(f *testing.F) {
+	modulartest.FuzzIndex(f, modular.NewIndexer[float64])
+}
@@ -0,0 +1,116 @@
+package modular
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// DefaultMinChunk is the minimum number of elements given to each goroutine by the Parallel methods,
+// used when they are given a minChunk < 1.
+// Smaller chunks spend more time on scheduling than they save.
+const DefaultMinChunk = 1 << 14
+
+// ParallelCongruent stores src[i] mod m in dst[i], splitting the work across up to GOMAXPROCS goroutines.
+// dst must be at least as long as src, and may be src itself.
+//
+// Each goroutine is given at least minChunk elements, and checks ctx between every minChunk elements.
+// If ctx is cancelled before the work is finished, it returns ctx.Err() and dst is left partially written.
+func (m Modulus[T]) ParallelCongruent(ctx context.Context, dst, src []T, minChunk int) error {
+	dst = dst[:len(src)]
+	return parallel(ctx, len(src), minChunk, func(lo, hi int) {
+		m.CongruentSlice(dst[lo:hi], src[lo:hi])
+	})
+}
+
+// ParallelIndex stores the index of src[i] in dst[i], splitting the work across up to GOMAXPROCS goroutines.
+// dst must be at least as long as src.
+//
+// Each goroutine is given at least minChunk elements, and checks ctx between every minChunk elements.
+// If ctx is cancelled before the work is finished, it returns ctx.Err() and dst is left partially written.
+func (i Indexer[T]) ParallelIndex(ctx context.Context, dst []int, src []T, minChunk int) error {
+	dst = dst[:len(src)]
+	return parallel(ctx, len(src), minChunk, func(lo, hi int) {
+		i.IndexSlice(dst[lo:hi], src[lo:hi])
+	})
+}
+
+// ParallelCongruent performs Congruent() on all axis of each vector in src, storing them in dst.
+// It splits the work the same way as Modulus.ParallelCongruent.
+func (m Vec2Modulus[T, V]) ParallelCongruent(ctx context.Context, dst, src []V, minChunk int) error {
+	dst = dst[:len(src)]
+	return parallel(ctx, len(src), minChunk, func(lo, hi int) {
+		m.CongruentSlice(dst[lo:hi], src[lo:hi])
+	})
+}
+
+// ParallelCongruent performs Congruent() on all axis of each vector in src, storing them in dst.
+// It splits the work the same way as Modulus.ParallelCongruent.
+func (m Vec3Modulus[T, V]) ParallelCongruent(ctx context.Context, dst, src []V, minChunk int) error {
+	dst = dst[:len(src)]
+	return parallel(ctx, len(src), minChunk, func(lo, hi int) {
+		m.CongruentSlice(dst[lo:hi], src[lo:hi])
+	})
+}
+
+// ParallelCongruent performs Congruent() on all axis of each vector in src, storing them in dst.
+// It splits the work the same way as Modulus.ParallelCongruent.
+func (m Vec4Modulus[T, V]) ParallelCongruent(ctx context.Context, dst, src []V, minChunk int) error {
+	dst = dst[:len(src)]
+	return parallel(ctx, len(src), minChunk, func(lo, hi int) {
+		m.CongruentSlice(dst[lo:hi], src[lo:hi])
+	})
+}
+
+// parallel calls fn over [0, n) in ranges of at most minChunk elements, split across up to GOMAXPROCS goroutines.
+// It stops early if ctx is cancelled, returning ctx.Err().
+func parallel(ctx context.Context, n, minChunk int, fn func(lo, hi int)) error {
+	if minChunk < 1 {
+		minChunk = DefaultMinChunk
+	}
+
+	var cancelled int32
+	work := func(lo, hi int) {
+		for lo < hi {
+			if ctx.Err() != nil {
+				atomic.StoreInt32(&cancelled, 1)
+				return
+			}
+			end := lo + minChunk
+			if end > hi || end < lo { // end < lo on overflow
+				end = hi
+			}
+			fn(lo, end)
+			lo = end
+		}
+	}
+
+	workers := runtime.GOMAXPROCS(0)
+	if w := n / minChunk; w < workers {
+		workers = w
+	}
+	if workers <= 1 {
+		work(0, n)
+	} else {
+		var wg sync.WaitGroup
+		per := (n + workers - 1) / workers
+		for lo := 0; lo < n; lo += per {
+			hi := lo + per
+			if hi > n {
+				hi = n
+			}
+			wg.Add(1)
+			go func(lo, hi int) {
+				defer wg.Done()
+				work(lo, hi)
+			}(lo, hi)
+		}
+		wg.Wait()
+	}
+
+	if atomic.LoadInt32(&cancelled) != 0 {
+		return ctx.Err()
+	}
+	return nil
+}
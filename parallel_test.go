@@ -0,0 +1,97 @@
+package modular_test
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/go-gl/mathgl/mgl64"
+	"github.com/stewi1014/modular"
+)
+
+func TestModulus_ParallelCongruent(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	src := make([]float64, 100003)
+	for i := range src {
+		src[i] = (r.Float64() - 0.5) * 1e6
+	}
+	m := modular.NewModulus(7.5)
+
+	for _, minChunk := range []int{0, 1, 1000, len(src) * 2} {
+		dst := make([]float64, len(src))
+		if err := m.ParallelCongruent(context.Background(), dst, src, minChunk); err != nil {
+			t.Fatalf("Modulus.ParallelCongruent(minChunk = %v) error = %v", minChunk, err)
+		}
+		for i, n := range src {
+			if want := m.Congruent(n); dst[i] != want {
+				t.Fatalf("Modulus.ParallelCongruent(minChunk = %v)[%v] = %v, want %v", minChunk, n, dst[i], want)
+			}
+		}
+	}
+
+	t.Run("Cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		if err := m.ParallelCongruent(ctx, make([]float64, len(src)), src, 100); err != context.Canceled {
+			t.Errorf("Modulus.ParallelCongruent() error = %v, want %v", err, context.Canceled)
+		}
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		if err := m.ParallelCongruent(context.Background(), nil, nil, 0); err != nil {
+			t.Errorf("Modulus.ParallelCongruent(nil) error = %v, want nil", err)
+		}
+	})
+}
+
+func TestIndexer_ParallelIndex(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	src := make([]float32, 50001)
+	for i := range src {
+		src[i] = (r.Float32() - 0.5) * 1e4
+	}
+	ind, _ := modular.NewIndexer(float32(360), 256)
+
+	dst := make([]int, len(src))
+	if err := ind.ParallelIndex(context.Background(), dst, src, 1024); err != nil {
+		t.Fatalf("Indexer.ParallelIndex() error = %v", err)
+	}
+	for i, n := range src {
+		if want := ind.Index(n); dst[i] != want {
+			t.Fatalf("Indexer.ParallelIndex()[%v] = %v, want %v", n, dst[i], want)
+		}
+	}
+}
+
+func TestVecModulus_ParallelCongruent(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	src := make([]mgl64.Vec3, 30000)
+	for i := range src {
+		src[i] = mgl64.Vec3{r.NormFloat64() * 100, r.NormFloat64() * 100, r.NormFloat64() * 100}
+	}
+	m := modular.NewVec3Modulus(mgl64.Vec3{10, 20, 30})
+
+	dst := make([]mgl64.Vec3, len(src))
+	if err := m.ParallelCongruent(context.Background(), dst, src, 1000); err != nil {
+		t.Fatalf("Vec3Modulus.ParallelCongruent() error = %v", err)
+	}
+	for i, v := range src {
+		if want := m.Congruent(v); dst[i] != want {
+			t.Fatalf("Vec3Modulus.ParallelCongruent()[%v] = %v, want %v", v, dst[i], want)
+		}
+	}
+}
+
+func BenchmarkModulus_ParallelCongruent(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	src, dst := make([]float64, 1<<20), make([]float64, 1<<20)
+	for i := range src {
+		src[i] = r.NormFloat64() * 1e6
+	}
+	m := modular.NewModulus(7.5)
+
+	b.SetBytes(int64(len(src) * 8))
+	for i := 0; i < b.N; i++ {
+		_ = m.ParallelCongruent(context.Background(), dst, src, 0)
+	}
+}
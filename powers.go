@@ -0,0 +1,111 @@
+package modular
+
+import (
+	"container/list"
+	"sync"
+
+	"github.com/bmkessler/fastdiv"
+)
+
+// DefaultPowersCacheSize is the default number of power tables kept by the powers cache.
+const DefaultPowersCacheSize = 1024
+
+// SetPowersCacheSize sets the number of power tables kept by the powers cache, evicting the least recently used tables beyond it.
+//
+// Every Modulus needs a table of 2**i mod its fraction, which for float64 is up to 16 KiB.
+// The tables depend only on the modulus's integer fraction, the significand frexp gives with its implied bit,
+// so moduli of the same float type with the same fraction share one table, whatever their exponents;
+// a modulus needing a longer table extends it, and shorter ones use its start.
+// A float32 and a float64 modulus only share a table if their integer fractions are equal,
+// which doesn't happen for the same value, as a float64 fraction has 29 more bits.
+// Evicted tables stay alive for as long as the moduli using them do.
+//
+// A size of 0 disables the cache, so every Modulus gets its own table.
+func SetPowersCacheSize(size int) {
+	if size < 0 {
+		size = 0
+	}
+
+	powersCache.mu.Lock()
+	defer powersCache.mu.Unlock()
+	powersCache.size = size
+	powersCache.evict()
+}
+
+// ClearPowersCache removes all tables from the powers cache.
+func ClearPowersCache() {
+	powersCache.mu.Lock()
+	defer powersCache.mu.Unlock()
+	powersCache.lru.Init()
+	powersCache.tables = make(map[uint64]*list.Element)
+}
+
+var powersCache = &powerTables{
+	size:   DefaultPowersCacheSize,
+	lru:    list.New(),
+	tables: make(map[uint64]*list.Element),
+}
+
+// powerTables is an LRU cache of power tables keyed by fraction.
+type powerTables struct {
+	mu     sync.Mutex
+	size   int
+	lru    *list.List // of powerTable, most recently used first
+	tables map[uint64]*list.Element
+}
+
+type powerTable struct {
+	fr     uint64
+	powers []uint64
+}
+
+// get returns the table of 2**i mod fr for 0 <= i < n, sharing it with other moduli where possible.
+// Tables are never modified once made, as moduli read them without locking;
+// a longer table replaces a shorter one in the cache instead.
+func (c *powerTables) get(fd fastdiv.Uint64, fr uint64, n int) []uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.size == 0 {
+		return powers(fd, nil, n)
+	}
+
+	if e, ok := c.tables[fr]; ok {
+		c.lru.MoveToFront(e)
+		t := e.Value.(*powerTable)
+		if len(t.powers) < n {
+			t.powers = powers(fd, t.powers, n)
+		}
+		return t.powers[:n:n]
+	}
+
+	t := &powerTable{fr: fr, powers: powers(fd, nil, n)}
+	c.tables[fr] = c.lru.PushFront(t)
+	c.evict()
+	return t.powers
+}
+
+// evict removes the least recently used tables until the cache is within its size.
+func (c *powerTables) evict() {
+	for c.lru.Len() > c.size {
+		e := c.lru.Back()
+		delete(c.tables, e.Value.(*powerTable).fr)
+		c.lru.Remove(e)
+	}
+}
+
+// powers returns a new table of 2**i mod fr for 0 <= i < n, copying what it can from prefix.
+func powers(fd fastdiv.Uint64, prefix []uint64, n int) []uint64 {
+	p := make([]uint64, n)
+	copy(p, prefix)
+
+	i := len(prefix)
+	if i == 0 && n > 0 {
+		p[0] = 1
+		i = 1
+	}
+	for ; i < n; i++ {
+		p[i] = fd.Mod(p[i-1] << 1)
+	}
+	return p
+}
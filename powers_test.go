@@ -0,0 +1,68 @@
+package modular_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stewi1014/modular"
+)
+
+func TestPowersCache(t *testing.T) {
+	defer modular.SetPowersCacheSize(modular.DefaultPowersCacheSize)
+
+	check := func(t *testing.T) {
+		t.Helper()
+		// Moduli with the same fraction at different exponents need different table lengths.
+		for _, mod := range []float64{3 * 0x1p-1000, 3, 3 * 0x1p1000, 0.1, 5e-324} {
+			m := modular.NewModulus(mod)
+			for _, n := range []float64{1e300, -1e-300, 12345.678, math.MaxFloat64} {
+				if got, want := m.Congruent(n), mod64(n, mod); got != want {
+					t.Errorf("Modulus{%v}.Congruent(%v) = %v, want %v", mod, n, got, want)
+				}
+			}
+			if f := float32(mod); f != 0 && !math.IsInf(float64(f), 0) {
+				m32 := modular.NewModulus(f)
+				if got, want := m32.Congruent(1e30), mod32(1e30, f); got != want {
+					t.Errorf("Modulus{%v}.Congruent(1e30) = %v, want %v", f, got, want)
+				}
+			}
+		}
+	}
+
+	t.Run("Shared", func(t *testing.T) {
+		modular.ClearPowersCache()
+		check(t)
+		check(t)
+	})
+
+	t.Run("Evicting", func(t *testing.T) {
+		modular.SetPowersCacheSize(1)
+		check(t)
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		modular.SetPowersCacheSize(0)
+		check(t)
+	})
+}
+
+func TestPowersCache_Allocs(t *testing.T) {
+	defer modular.SetPowersCacheSize(modular.DefaultPowersCacheSize)
+
+	modular.SetPowersCacheSize(modular.DefaultPowersCacheSize)
+	modular.NewModulusRange(1.75, 1e300)
+	if allocs := testing.AllocsPerRun(100, func() { modular.NewModulusRange(3.5, 1e300) }); allocs != 0 {
+		t.Errorf("NewModulusRange() with a cached table allocates %v times, want 0", allocs)
+	}
+
+	modular.SetPowersCacheSize(0)
+	if allocs := testing.AllocsPerRun(100, func() { modular.NewModulusRange(3.5, 1e300) }); allocs != 1 {
+		t.Errorf("NewModulusRange() with the cache disabled allocates %v times, want 1", allocs)
+	}
+}
+
+func BenchmarkNewModulus(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		modular.NewModulus(benchmarkModulo)
+	}
+}
@@ -0,0 +1,240 @@
+package modular
+
+import (
+	"math/big"
+)
+
+// NewRangeModulus creates a new RangeModulus that reduces numbers into [lo, lo+m).
+// The sign of the modulus is ignored.
+//
+// It isn't to be confused with NewModulusRange, which limits the size of a Modulus's power table.
+//
+// An Infinite or NaN modulus or lower bound gives NaN for every number,
+// as does a modulus too small to change lo, which would leave an empty range.
+//
+// Special cases:
+//		NewRangeModulus(0, lo) = panic(integer divide by zero)
+//		NewRangeModulus(m, lo) = NaN range if lo + m rounds to lo
+func NewRangeModulus[T Float](modulus, lo T) RangeModulus[T] {
+	return newRangeModulus(NewModulus(modulus), lo, false)
+}
+
+// NewSymmetricModulus creates a new RangeModulus that reduces numbers into (-m/2, m/2],
+// such as angles into (-π, π] with a modulus of 2π.
+// The sign of the modulus is ignored.
+//
+// NewRangeModulus(m, -m/2) gives the other symmetric range, [-m/2, m/2).
+//
+// Special cases:
+//		NewSymmetricModulus(0) = panic(integer divide by zero)
+func NewSymmetricModulus[T Float](modulus T) RangeModulus[T] {
+	mod := NewModulus(modulus)
+	return newRangeModulus(mod, -mod.mod/2, true)
+}
+
+// newRangeModulus creates a new RangeModulus over (lo, lo+m] if upper is true, or [lo, lo+m) otherwise.
+func newRangeModulus[T Float](mod Modulus[T], lo T, upper bool) RangeModulus[T] {
+	if mod.mod != mod.mod || isInf(mod.mod) || isInf(lo) || lo+mod.mod == lo {
+		lo = nan[T]()
+	}
+	return RangeModulus[T]{
+		mod:   mod,
+		lo:    lo,
+		hi:    lo + mod.mod,
+		mid:   lo + mod.mod/2,
+		upper: upper,
+	}
+}
+
+// RangeModulus defines a modulus that reduces numbers into a range other than [0, m),
+// either [lo, lo+m) for any lo, or the symmetric (-m/2, m/2].
+//
+// Numbers in a range symmetric about 0, or starting at 0, are reduced exactly and rounded once, like Modulus.Congruent.
+// Other ranges are reduced relative to their centre, which adds a rounding error of up to an ulp of the range's bounds.
+// Either way, the result is always within the range; a number that rounds to the excluded bound gives the included one.
+type RangeModulus[T Float] struct {
+	mod         Modulus[T]
+	lo, hi, mid T
+	upper       bool // The range is (lo, hi] rather than [lo, hi).
+}
+
+// Mod returns the modulus.
+func (m RangeModulus[T]) Mod() T {
+	return m.mod.mod
+}
+
+// Range returns the bounds of the range, and whether it includes its upper bound rather than its lower one.
+func (m RangeModulus[T]) Range() (lo, hi T, upper bool) {
+	return m.lo, m.hi, m.upper
+}
+
+// Congruent returns the number in m's range that is congruent to n.
+//
+// Special cases:
+//		RangeModulus{NaN}.Congruent(n) = NaN
+//		RangeModulus{±Inf}.Congruent(n) = NaN
+//		RangeModulus{m}.Congruent(±Inf) = NaN
+//		RangeModulus{m}.Congruent(NaN) = NaN
+func (m RangeModulus[T]) Congruent(n T) T {
+	var x T
+	switch {
+	case m.mid == 0:
+		x = m.mod.Dist(0, n)
+	case m.lo == 0:
+		x = m.mod.Congruent(n)
+	default:
+		x = m.mid + m.mod.Dist(m.mid, m.mod.Congruent(n))
+	}
+
+	if m.upper {
+		if x <= m.lo || x > m.hi {
+			return m.hi
+		}
+	} else if x >= m.hi || x < m.lo {
+		return m.lo
+	}
+	return x
+}
+
+// CongruentSlice stores the number in m's range that is congruent to src[i] in dst[i].
+// dst must be at least as long as src, and may be src itself.
+func (m RangeModulus[T]) CongruentSlice(dst, src []T) {
+	dst = dst[:len(src)]
+	for i, n := range src {
+		dst[i] = m.Congruent(n)
+	}
+}
+
+// CongruentInPlace replaces each element of s with the number in m's range that is congruent to it.
+func (m RangeModulus[T]) CongruentInPlace(s []T) {
+	m.CongruentSlice(s, s)
+}
+
+// Dist returns the distance and direction of n1 to n2.
+//
+// Like Modulus.Dist, it picks the shortest distance, which is the same in every range;
+// but it breaks the tie at half the modulus the same way as the range,
+// giving a distance in (-m/2, m/2] for a range including its upper bound, or [-m/2, m/2) otherwise.
+func (m RangeModulus[T]) Dist(n1, n2 T) T {
+	if m.lo != m.lo {
+		return m.lo
+	}
+	d := m.mod.Dist(n1, n2)
+	if !m.upper && d == m.mod.mod-d {
+		return -d
+	}
+	return d
+}
+
+// GetCongruent returns the closest number to n1 that is congruent to n2, breaking ties like Dist.
+func (m RangeModulus[T]) GetCongruent(n1, n2 T) T {
+	return n1 - m.Dist(n2, n1)
+}
+
+// NewIndexer creates a new RangeIndexer from the RangeModulus.
+//
+// Special cases:
+//		NewIndexer(m, 0) = ErrBadIndex
+//		NewIndexer(±Inf, i) = ErrBadModulo
+//		NewIndexer(NaN, i) = ErrBadModulo
+func (m RangeModulus[T]) NewIndexer(index int) (RangeIndexer[T], error) {
+	i, err := m.mod.NewIndexer(index)
+	if err != nil {
+		return RangeIndexer[T]{}, err
+	}
+	if m.lo != m.lo {
+		return RangeIndexer[T]{}, ErrBadModulo
+	}
+	return RangeIndexer[T]{
+		RangeModulus: m,
+		i:            i,
+		offset:       indexOffset(m.lo, m.mod.mod, index),
+	}, nil
+}
+
+// indexOffset returns the index of lo mod m, or -1 if it isn't exactly on the boundary of an index.
+func indexOffset[T Float](lo, m T, index int) int {
+	q := new(big.Rat).SetFloat64(float64(lo))
+	q.Quo(q, new(big.Rat).SetFloat64(float64(m)))
+	q.Mul(q, new(big.Rat).SetInt64(int64(index)))
+	if !q.IsInt() {
+		return -1
+	}
+	return int(new(big.Int).Mod(q.Num(), big.NewInt(int64(index))).Int64())
+}
+
+// RangeIndexer maps a RangeModulus's range to a range of integers, with 0 at its lower bound.
+//
+// Each index covers an equal part of the range, open at the same end as the range,
+// so that (-π, π] split in two gives (-π, 0] and (0, π].
+type RangeIndexer[T Float] struct {
+	RangeModulus[T]
+	i      Indexer[T]
+	offset int // The index of lo mod m, or -1 if the range doesn't start on the boundary of an index.
+}
+
+// Indexes returns the number of indexes the range is mapped to.
+func (i RangeIndexer[T]) Indexes() int {
+	return i.i.i
+}
+
+// Index indexes n.
+//
+// If n is NaN or ±Inf, it returns the index.
+// Otherwise, it returns floor(index * (x - lo) / m) for the congruent number x in the range,
+// or index - 1 - floor(index * (hi - x) / m) for a range including its upper bound,
+// which always satisfies 0 <= num < index.
+// It is exact if lo is on the boundary of an index, as in a symmetric range split in an even number of indexes;
+// otherwise x - lo is rounded.
+//
+// Special cases:
+//		Index(NaN) = index
+//		Index(±Inf) = index
+func (i RangeIndexer[T]) Index(n T) int {
+	index := i.i.i
+	if n != n || isInf(n) {
+		return index
+	}
+
+	if i.offset >= 0 {
+		// The range's indexes are those of [0, m) shifted by the offset, which is exact.
+		if i.upper {
+			j := i.i.Index(-n) - (index - i.offset)
+			if j < 0 {
+				j += index
+			}
+			return index - 1 - j
+		}
+		j := i.i.Index(n) - i.offset
+		if j < 0 {
+			j += index
+		}
+		return j
+	}
+
+	x := i.Congruent(n)
+
+	if i.upper {
+		// The range mirrored, so that the included bound is at 0.
+		p := i.hi - x
+		if p >= i.mod.mod {
+			return 0
+		}
+		return index - 1 - i.i.Index(p)
+	}
+
+	p := x - i.lo
+	if p >= i.mod.mod {
+		return index - 1
+	}
+	return i.i.Index(p)
+}
+
+// IndexSlice stores the index of src[i] in dst[i].
+// dst must be at least as long as src.
+func (i RangeIndexer[T]) IndexSlice(dst []int, src []T) {
+	dst = dst[:len(src)]
+	for j, n := range src {
+		dst[j] = i.Index(n)
+	}
+}
@@ -0,0 +1,216 @@
+package modular_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stewi1014/modular"
+	"github.com/stewi1014/modular/modulartest"
+)
+
+func TestRangeModulus_Congruent(t *testing.T) {
+	tests := []struct {
+		name string
+		m    modular.RangeModulus[float64]
+		arg  float64
+		want float64
+	}{
+		{
+			name: "Symmetric",
+			m:    modular.NewSymmetricModulus(360.0),
+			arg:  190,
+			want: -170,
+		},
+		{
+			name: "Symmetric upper bound",
+			m:    modular.NewSymmetricModulus(360.0),
+			arg:  180,
+			want: 180,
+		},
+		{
+			name: "Symmetric lower bound",
+			m:    modular.NewSymmetricModulus(360.0),
+			arg:  -180,
+			want: 180,
+		},
+		{
+			name: "Symmetric small negative number",
+			m:    modular.NewSymmetricModulus(360.0),
+			arg:  -1e-300,
+			want: -1e-300,
+		},
+		{
+			name: "Symmetric large number",
+			m:    modular.NewSymmetricModulus(360.0),
+			arg:  -1e22,
+			want: 80,
+		},
+		{
+			name: "Longitude",
+			m:    modular.NewRangeModulus(360.0, -180),
+			arg:  -540,
+			want: -180,
+		},
+		{
+			name: "Longitude upper bound",
+			m:    modular.NewRangeModulus(360.0, -180),
+			arg:  180,
+			want: -180,
+		},
+		{
+			name: "Zero lower bound",
+			m:    modular.NewRangeModulus(360.0, 0),
+			arg:  -1e-300,
+			want: 0,
+		},
+		{
+			name: "Offset range",
+			m:    modular.NewRangeModulus(12.0, 3),
+			arg:  2,
+			want: 14,
+		},
+		{
+			name: "Offset range bound",
+			m:    modular.NewRangeModulus(12.0, 3),
+			arg:  -9,
+			want: 3,
+		},
+		{
+			name: "Offset range fraction",
+			m:    modular.NewRangeModulus(-12.0, 3),
+			arg:  27.5,
+			want: 3.5,
+		},
+		{
+			name: "Infinite number",
+			m:    modular.NewSymmetricModulus(360.0),
+			arg:  math.Inf(1),
+			want: math.NaN(),
+		},
+		{
+			name: "Infinite modulus",
+			m:    modular.NewRangeModulus(math.Inf(1), 3),
+			arg:  1,
+			want: math.NaN(),
+		},
+		{
+			name: "Modulus below an ulp of the lower bound",
+			m:    modular.NewRangeModulus(1.0, 1e20),
+			arg:  1e20,
+			want: math.NaN(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lo, hi, _ := tt.m.Range()
+			got := tt.m.Congruent(tt.arg)
+			if got != tt.want && !(math.IsNaN(got) && math.IsNaN(tt.want)) {
+				t.Errorf("RangeModulus{%v, %v}.Congruent(%v) = %v, want %v", lo, hi, tt.arg, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRangeModulus_CongruentRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < randomTestNum/4; i++ {
+		mod := math.Abs(math.Ldexp(r.Float64()+0.5, r.Intn(200)-100))
+		lo := (r.Float64()*4 - 2) * mod
+		n := randomFloat64(r)
+
+		// Symmetric ranges are exact.
+		sm := modular.NewSymmetricModulus(mod)
+		if got, want := sm.Congruent(n), modulartest.Dist(0, n, mod); got != want {
+			t.Fatalf("RangeModulus{(%v, %v]}.Congruent(%v) = %v, want %v", -mod/2, mod/2, n, got, want)
+		}
+
+		// Others are within the range, and congruent to within an ulp of the bounds.
+		m := modular.NewRangeModulus(mod, lo)
+		_, hi, _ := m.Range()
+		got := m.Congruent(n)
+		if got < lo || got >= hi {
+			t.Fatalf("RangeModulus{[%v, %v)}.Congruent(%v) = %v, which is out of range", lo, hi, n, got)
+		}
+		mm := modular.NewModulus(mod)
+		d := math.Abs(mm.Dist(mm.Congruent(got), mm.Congruent(n)))
+		if tol := 2 * (math.Nextafter(math.Max(math.Abs(lo), math.Abs(hi)), math.Inf(1)) - math.Max(math.Abs(lo), math.Abs(hi))); d > tol {
+			t.Fatalf("RangeModulus{[%v, %v)}.Congruent(%v) = %v, which is %v from a congruent number", lo, hi, n, got, d)
+		}
+	}
+}
+
+func TestRangeModulus_Dist(t *testing.T) {
+	sym := modular.NewSymmetricModulus(360.0)
+	lon := modular.NewRangeModulus(360.0, -180)
+	tests := []struct {
+		name     string
+		m        modular.RangeModulus[float64]
+		n1, n2   float64
+		dist     float64
+		getCongr float64
+	}{
+		{name: "Symmetric", m: sym, n1: 170, n2: -170, dist: 20, getCongr: 190},
+		{name: "Symmetric tie", m: sym, n1: 0, n2: 180, dist: 180, getCongr: -180},
+		{name: "Longitude", m: lon, n1: 170, n2: -170, dist: 20, getCongr: 190},
+		{name: "Longitude tie", m: lon, n1: 0, n2: 180, dist: -180, getCongr: 180},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.m.Dist(tt.n1, tt.n2); got != tt.dist {
+				t.Errorf("RangeModulus.Dist(%v, %v) = %v, want %v", tt.n1, tt.n2, got, tt.dist)
+			}
+			if got := tt.m.GetCongruent(tt.n1, tt.n2); got != tt.getCongr {
+				t.Errorf("RangeModulus.GetCongruent(%v, %v) = %v, want %v", tt.n1, tt.n2, got, tt.getCongr)
+			}
+		})
+	}
+}
+
+func TestRangeIndexer_Index(t *testing.T) {
+	sym, err := modular.NewSymmetricModulus(360.0).NewIndexer(4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lon, err := modular.NewRangeModulus(360.0, -180).NewIndexer(4)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		n        float64
+		sym, lon int
+	}{
+		{n: -180, sym: 3, lon: 0},
+		{n: -90, sym: 0, lon: 1},
+		{n: -89, sym: 1, lon: 1},
+		{n: 0, sym: 1, lon: 2},
+		{n: 1, sym: 2, lon: 2},
+		{n: 180, sym: 3, lon: 0},
+		{n: -1e-300, sym: 1, lon: 1},
+		{n: math.NaN(), sym: 4, lon: 4},
+	}
+	for _, tt := range tests {
+		if got := sym.Index(tt.n); got != tt.sym {
+			t.Errorf("RangeIndexer{(-180, 180], 4}.Index(%v) = %v, want %v", tt.n, got, tt.sym)
+		}
+		if got := lon.Index(tt.n); got != tt.lon {
+			t.Errorf("RangeIndexer{[-180, 180), 4}.Index(%v) = %v, want %v", tt.n, got, tt.lon)
+		}
+	}
+
+	// 3 isn't on the boundary of an index of 12 split in 5.
+	off, _ := modular.NewRangeModulus(12.0, 3).NewIndexer(5)
+	for n, want := range map[float64]int{3: 0, 2: 4, 14.9: 4, 5.39: 0, 5.41: 1, -9: 0} {
+		if got := off.Index(n); got != want {
+			t.Errorf("RangeIndexer{[3, 15), 5}.Index(%v) = %v, want %v", n, got, want)
+		}
+	}
+
+	if _, err := modular.NewRangeModulus(math.NaN(), 0).NewIndexer(4); err != modular.ErrBadModulo {
+		t.Errorf("NewRangeModulus(NaN, 0).NewIndexer(4) error = %v, want %v", err, modular.ErrBadModulo)
+	}
+	if _, err := modular.NewRangeModulus(1.0, math.Inf(1)).NewIndexer(4); err != modular.ErrBadModulo {
+		t.Errorf("NewRangeModulus(1, +Inf).NewIndexer(4) error = %v, want %v", err, modular.ErrBadModulo)
+	}
+}
@@ -0,0 +1,228 @@
+package modular
+
+import (
+	"math/bits"
+	"unsafe"
+
+	"github.com/bmkessler/fastdiv"
+)
+
+// NewRationalModulus creates a new RationalModulus with the exact modulus p/q.
+//
+// Periods like 0.1 or 1/3 aren't representable as floats,
+// so a Modulus over them reduces by a slightly different period and drifts from the true result as numbers grow.
+// A RationalModulus reduces by p/q exactly, rounding only the final result.
+//
+// The fraction is reduced to its lowest terms, and its sign is ignored.
+//
+// Special cases:
+//		NewRationalModulus(0, q) = panic(integer divide by zero)
+//		NewRationalModulus(p, 0) = panic(integer divide by zero)
+func NewRationalModulus[T Float](p, q int64) RationalModulus[T] {
+	up, uq := uint64(p), uint64(q)
+	if p < 0 {
+		up = -up
+	}
+	if q < 0 {
+		uq = -uq
+	}
+	if up == 0 || uq == 0 {
+		panic("integer divide by zero")
+	}
+	g := gcd(up, uq)
+	up, uq = up/g, uq/g
+
+	fd := fastdiv.NewUint64(up)
+	m := RationalModulus[T]{
+		fraction: fraction{
+			fd: fd,
+			fr: up,
+		},
+		q: uq,
+	}
+
+	// Integer numbers are reduced by p, multiplied by every power of two an integer float can have.
+	size := unsafe.Sizeof(m.mod)
+	powerlen := maxExp(size) - maxExp(size)/2 - fractionBits(size)
+	if up&(up-1) != 0 && !tableFree {
+		m.powers = powersCache.get(fd, up, int(powerlen))
+	}
+
+	m.mod = m.round(up, 0, 0, false)
+	return m
+}
+
+// RationalModulus defines a modulus that is an exact fraction p/q.
+// Congruent gives the exact n mod p/q, correctly rounded to the nearest float.
+//
+// It reuses the integer machinery of Modulus, reducing n*q by p,
+// but the final division by q is done in multiword arithmetic, so it is several times slower than a Modulus.
+type RationalModulus[T Float] struct {
+	fraction // Reduces by p
+	q        uint64
+	mod      T
+}
+
+// Mod returns the modulus, p/q rounded to the nearest float.
+func (m RationalModulus[T]) Mod() T {
+	return m.mod
+}
+
+// Ratio returns the modulus as a fraction in its lowest terms.
+func (m RationalModulus[T]) Ratio() (p, q uint64) {
+	return m.fr, m.q
+}
+
+// Congruent returns n mod p/q, rounded to the nearest float.
+//
+// The result satisfies 0 <= r <= Mod();
+// it is only equal to Mod() if the exact result is within rounding of p/q.
+//
+// Special cases:
+//		RationalModulus{}.Congruent(n) = NaN
+//		RationalModulus{p/q}.Congruent(±Inf) = NaN
+//		RationalModulus{p/q}.Congruent(NaN) = NaN
+func (m RationalModulus[T]) Congruent(n T) T {
+	if n >= 0 && n < m.mod {
+		// m.mod is p/q rounded, so anything smaller is also smaller than p/q.
+		return n
+	}
+	if m.q == 0 { // The zero RationalModulus, with a modulus of 0/0
+		return nan[T]()
+	}
+
+	fracBits := fractionBits(unsafe.Sizeof(n))
+	nfr, nexp := frexp(n)
+	if nexp == maxExp(unsafe.Sizeof(n)) {
+		return nan[T]()
+	}
+	if nexp == 0 {
+		nexp = 1 // Denormalised numbers share the smallest exponent.
+	}
+	exp := int(nexp) - int(maxExp(unsafe.Sizeof(n))/2) - int(fracBits)
+
+	// With |n| = nfr * 2**exp, |n| mod p/q = (nfr*q*2**exp mod p) / q.
+	// The part of nfr*q*2**exp below 1 can't be reduced by p, so it is kept as a 128 bit fraction of the result's numerator.
+	hi, lo := bits.Mul64(nfr, m.q)
+	var (
+		c      uint64 // Integer part of the numerator, < p
+		f1, f0 uint64 // Fractional part of the numerator, in units of 2**-128
+		sticky bool   // Whether there are more fraction bits below f0
+	)
+	switch s := uint(-exp); {
+	case exp >= 0:
+		_, c = bits.Div64(m.fd.Mod(hi), lo, m.fr)
+		c = m.modExp(c, uint(exp))
+	case s < 128:
+		ihi, ilo := shr128(hi, lo, s)
+		_, c = bits.Div64(m.fd.Mod(ihi), ilo, m.fr)
+		f1, f0 = shl128(hi, lo, 128-s)
+	default: // nfr*q < 2**128, so the integer part is 0
+		if n > 0 {
+			return n // n < p/q, and is exact
+		}
+		f1, f0 = shr128(hi, lo, s-128)
+		if s >= 256 {
+			sticky = hi|lo != 0
+		} else {
+			rhi, rlo := shl128(hi, lo, 256-s)
+			sticky = rhi|rlo != 0
+		}
+	}
+
+	if n < 0 && (c|f1|f0 != 0 || sticky) {
+		// p/q - r. The numerator is p - c - 1 + (1 - f).
+		if f1|f0 == 0 && !sticky {
+			c = m.fr - c
+		} else {
+			c = m.fr - c - 1
+			f1, f0 = ^f1, ^f0
+			if !sticky { // A truncated fraction's complement is already truncated.
+				var carry uint64
+				f0, carry = bits.Add64(f0, 1, 0)
+				f1 += carry
+			}
+		}
+	}
+
+	return m.round(c, f1, f0, sticky)
+}
+
+// CongruentSlice stores src[i] mod p/q in dst[i].
+// dst must be at least as long as src, and may be src itself.
+func (m RationalModulus[T]) CongruentSlice(dst, src []T) {
+	dst = dst[:len(src)]
+	for i, n := range src {
+		dst[i] = m.Congruent(n)
+	}
+}
+
+// round returns (c + f/2**128) / q correctly rounded,
+// where f is the 128 bit number f1, f0 and sticky reports whether f has been truncated.
+func (m RationalModulus[T]) round(c, f1, f0 uint64, sticky bool) T {
+	// Scale an exact numerator up to keep as many quotient bits as possible.
+	// A truncated one is always at least 2**127, which leaves at least 64 quotient bits.
+	shift := 0
+	if !sticky {
+		switch {
+		case c != 0:
+			shift = bits.LeadingZeros64(c)
+		case f1 != 0:
+			shift = 64 + bits.LeadingZeros64(f1)
+		case f0 != 0:
+			shift = 128 + bits.LeadingZeros64(f0)
+		default:
+			return 0
+		}
+		for s := shift; s > 0; {
+			step := s
+			if step > 63 {
+				step = 63
+			}
+			c = c<<uint(step) | f1>>uint(64-step)
+			f1 = f1<<uint(step) | f0>>uint(64-step)
+			f0 <<= uint(step)
+			s -= step
+		}
+	}
+
+	// Long division of the 192 bit numerator by q.
+	q2, r := bits.Div64(0, c, m.q)
+	q1, r := bits.Div64(r, f1, m.q)
+	q0, r := bits.Div64(r, f0, m.q)
+	sticky = sticky || r != 0
+
+	// Take the top 64 bits of the quotient, which is at least 2**64.
+	var mant uint64
+	exp := -128 - shift
+	if q2 != 0 {
+		lz := uint(bits.LeadingZeros64(q2))
+		mant = q2<<lz | q1>>(64-lz)
+		sticky = sticky || q1<<lz|q0 != 0
+		exp += 128 - int(lz)
+	} else {
+		lz := uint(bits.LeadingZeros64(q1))
+		mant = q1<<lz | q0>>(64-lz)
+		sticky = sticky || q0<<lz != 0
+		exp += 64 - int(lz)
+	}
+
+	var f T
+	return floatFromBits[T](roundBits(mant, exp, sticky, unsafe.Sizeof(f)))
+}
+
+// shl128 returns hi, lo << s for s <= 128.
+func shl128(hi, lo uint64, s uint) (uint64, uint64) {
+	if s >= 64 {
+		return lo << (s - 64), 0
+	}
+	return hi<<s | lo>>(64-s), lo << s
+}
+
+// shr128 returns hi, lo >> s, which is 0 for s >= 128.
+func shr128(hi, lo uint64, s uint) (uint64, uint64) {
+	if s >= 64 {
+		return 0, hi >> (s - 64)
+	}
+	return hi >> s, lo>>s | hi<<(64-s)
+}
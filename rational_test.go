@@ -0,0 +1,194 @@
+package modular_test
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/stewi1014/modular"
+	"github.com/stewi1014/modular/modulartest"
+)
+
+func TestRationalModulus_Congruent(t *testing.T) {
+	tests := []struct {
+		name string
+		p, q int64
+		arg  float64
+		want float64
+	}{
+		{
+			name: "Basic test",
+			p:    7,
+			q:    2,
+			arg:  10,
+			want: 3,
+		},
+		{
+			name: "Exact multiple of a tenth",
+			p:    1,
+			q:    10,
+			arg:  1e16,
+			want: 0,
+		},
+		{
+			name: "Negative multiple of a third",
+			p:    1,
+			q:    3,
+			arg:  -1,
+			want: 0,
+		},
+		{
+			name: "Negative number",
+			p:    1,
+			q:    3,
+			arg:  -0.25,
+			want: 1.0 / 12,
+		},
+		{
+			name: "Negative number with fraction",
+			p:    214931,
+			q:    17,
+			arg:  -115.71949429260803,
+			want: 12527.280505707393,
+		},
+		{
+			name: "Negative modulus",
+			p:    -7,
+			q:    2,
+			arg:  10,
+			want: 3,
+		},
+		{
+			name: "Small number",
+			p:    1,
+			q:    3,
+			arg:  0.25,
+			want: 0.25,
+		},
+		{
+			name: "Rounds up to modulus",
+			p:    1,
+			q:    1,
+			arg:  -0x1p-1074,
+			want: 1,
+		},
+		{
+			name: "Large number",
+			p:    1,
+			q:    3,
+			arg:  1e300,
+			want: 0,
+		},
+		{
+			name: "Infinite number",
+			p:    1,
+			q:    3,
+			arg:  math.Inf(1),
+			want: math.NaN(),
+		},
+		{
+			name: "NaN number",
+			p:    1,
+			q:    3,
+			arg:  math.NaN(),
+			want: math.NaN(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := modular.NewRationalModulus[float64](tt.p, tt.q)
+			if got := m.Congruent(tt.arg); got != tt.want && !(math.IsNaN(got) && math.IsNaN(tt.want)) {
+				t.Errorf("RationalModulus{%v/%v}.Congruent(%v) = %v, want %v", tt.p, tt.q, tt.arg, got, tt.want)
+			}
+		})
+	}
+
+	t.Run("Zero value", func(t *testing.T) {
+		var m modular.RationalModulus[float64]
+		for _, n := range []float64{0, 1, -1} {
+			if got := m.Congruent(n); !math.IsNaN(got) {
+				t.Errorf("RationalModulus{}.Congruent(%v) = %v, want NaN", n, got)
+			}
+		}
+	})
+}
+
+func TestRationalModulus_CongruentRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < randomTestNum; i++ {
+		p := r.Int63n(1<<uint(r.Intn(63))) + 1
+		q := r.Int63n(1<<uint(r.Intn(63))) + 1
+		n := math.Ldexp(r.Float64()*2-1, r.Intn(2200)-1100)
+		if math.IsInf(n, 0) {
+			continue
+		}
+
+		m64 := modular.NewRationalModulus[float64](p, q)
+		want64, _ := modulartest.CongruentRat(new(big.Rat).SetFloat64(n), big.NewRat(p, q)).Float64()
+		if got := m64.Congruent(n); got != want64 {
+			t.Fatalf("RationalModulus{%v/%v}.Congruent(%v) = %v, want %v", p, q, n, got, want64)
+		}
+
+		n32 := float32(n)
+		if math.IsInf(float64(n32), 0) {
+			continue
+		}
+		m32 := modular.NewRationalModulus[float32](p, q)
+		want32, _ := modulartest.CongruentRat(new(big.Rat).SetFloat64(float64(n32)), big.NewRat(p, q)).Float32()
+		if got := m32.Congruent(n32); got != want32 {
+			t.Fatalf("RationalModulus{%v/%v}.Congruent(%v) = %v, want %v", p, q, n32, got, want32)
+		}
+	}
+}
+
+func TestNewRationalModulus(t *testing.T) {
+	m := modular.NewRationalModulus[float64](-3, 6)
+	if p, q := m.Ratio(); p != 1 || q != 2 {
+		t.Errorf("NewRationalModulus(-3, 6).Ratio() = %v, %v, want 1, 2", p, q)
+	}
+	if got := m.Mod(); got != 0.5 {
+		t.Errorf("NewRationalModulus(-3, 6).Mod() = %v, want 0.5", got)
+	}
+	if got, want := modular.NewRationalModulus[float64](1, 10).Mod(), 0.1; got != want {
+		t.Errorf("NewRationalModulus(1, 10).Mod() = %v, want %v", got, want)
+	}
+	if got, want := modular.NewRationalModulus[float32](math.MinInt64, 3).Mod(), float32(1<<63)/3; got != want {
+		t.Errorf("NewRationalModulus(MinInt64, 3).Mod() = %v, want %v", got, want)
+	}
+
+	for _, c := range [][2]int64{{0, 1}, {1, 0}} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("NewRationalModulus(%v, %v) didn't panic", c[0], c[1])
+				}
+			}()
+			modular.NewRationalModulus[float64](c[0], c[1])
+		}()
+	}
+}
+
+func TestRationalModulus_CongruentSlice(t *testing.T) {
+	m := modular.NewRationalModulus[float64](1, 10)
+	src := []float64{0, 1, -0.35, 1e16, 1e300, math.NaN()}
+	dst := make([]float64, len(src))
+	m.CongruentSlice(dst, src)
+	for i, n := range src {
+		if want := m.Congruent(n); dst[i] != want && !(math.IsNaN(dst[i]) && math.IsNaN(want)) {
+			t.Errorf("RationalModulus.CongruentSlice()[%v] = %v, want %v", n, dst[i], want)
+		}
+	}
+}
+
+func BenchmarkRationalModulus(b *testing.B) {
+	m := modular.NewRationalModulus[float64](1, 10)
+	for _, n := range benchmarks {
+		b.Run(fmt.Sprintf("RationalModulus.Congruent(%v)", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				float64Sink = m.Congruent(n)
+			}
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package modular
+
+import (
+	"math"
+)
+
+// scaleLimit bounds the exponents ScaleMod works with directly, far beyond the range of any float.
+const scaleLimit = 1 << 20
+
+// ScaleMod returns (n * 2**k) mod m, for any k.
+//
+// Like MulMod, the scaled number is reduced exactly and rounded once, to nearest,
+// so it works for exponents far outside the range of a float, such as the iterates of a doubling map.
+// Like Congruent, a number just below a multiple of m can round to m.
+//
+// Special cases:
+//		Modulus{NaN}.ScaleMod(n, k) = NaN
+//		Modulus{±Inf}.ScaleMod(n, k) = Congruent(n * 2**k)
+//		Modulus{m}.ScaleMod(±Inf, k) = NaN
+//		Modulus{m}.ScaleMod(NaN, k) = NaN
+func (m Modulus[T]) ScaleMod(n T, k int) T {
+	switch {
+	case m.mod == 0 || m.mod != m.mod || n != n || isInf(n):
+		return nan[T]()
+	case isInf(m.mod):
+		return m.Congruent(T(math.Ldexp(float64(n), k)))
+	}
+
+	nfr, nexp := floatParts(n)
+	switch {
+	case k > scaleLimit:
+		// n * 2**k is far above the modulus's last bit, so its fraction can be reduced by most of 2**k first.
+		return m.mulMod(0, m.modExp(nfr, uint(k-scaleLimit)), nexp+scaleLimit, n < 0)
+	case k < -scaleLimit:
+		// n * 2**k is far below the modulus's last bit either way.
+		k = -scaleLimit
+	}
+	return m.mulMod(0, nfr, nexp+k, n < 0)
+}
@@ -0,0 +1,85 @@
+package modular_test
+
+import (
+	"math"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/stewi1014/modular"
+	"github.com/stewi1014/modular/modulartest"
+)
+
+func TestModulus_ScaleMod(t *testing.T) {
+	tests := []struct {
+		name    string
+		modulus float64
+		arg     float64
+		k       int
+		want    float64
+	}{
+		{name: "Basic test", modulus: 10, arg: 1, k: 100, want: 6},
+		{name: "Fraction", modulus: 1, arg: 0.1, k: 3, want: 0.8},
+		{name: "Negative exponent", modulus: 1, arg: 3, k: -1, want: 0.5},
+		{name: "Negative number", modulus: 1, arg: -0.1, k: 3, want: 0.19999999999999996},
+		{name: "Large exponent", modulus: 3, arg: 1, k: 1e9, want: 1},
+		{name: "Largest exponent", modulus: 3, arg: 1, k: math.MaxInt, want: 2},
+		{name: "Smallest exponent", modulus: 1, arg: 1, k: math.MinInt, want: 0},
+		{name: "Smallest exponent of a negative number", modulus: 1, arg: -1, k: math.MinInt, want: 1},
+		{name: "Power of two modulus", modulus: 0.5, arg: 0.1, k: 2000, want: 0},
+		{name: "Denormalised result", modulus: 1, arg: 1, k: -1074, want: math.SmallestNonzeroFloat64},
+		{name: "Infinite modulus", modulus: math.Inf(1), arg: 3, k: 2, want: 12},
+		{name: "Infinite number", modulus: 1, arg: math.Inf(-1), k: 2, want: math.NaN()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := modular.NewModulus(tt.modulus)
+			if got := m.ScaleMod(tt.arg, tt.k); got != tt.want && !(math.IsNaN(got) && math.IsNaN(tt.want)) {
+				t.Errorf("Modulus{%v}.ScaleMod(%v, %v) = %v, want %v", tt.modulus, tt.arg, tt.k, got, tt.want)
+			}
+		})
+	}
+}
+
+// bigScaleMod returns (x * 2**k) mod m, rounded to a float64, using modular exponentiation for large k.
+func bigScaleMod(x float64, k int, m float64) float64 {
+	xfr, xexp := math.Frexp(x)
+	mfr, mexp := math.Frexp(m)
+	xi, mi := big.NewInt(int64(math.Ldexp(xfr, 53))), big.NewInt(int64(math.Ldexp(mfr, 53)))
+
+	// x * 2**k = xi * 2**(xexp + k - 53), and m = mi * 2**(mexp - 53).
+	if d := xexp + k - mexp; d >= 0 {
+		r := new(big.Int).Exp(big.NewInt(2), big.NewInt(int64(d)), mi)
+		r.Mul(r, xi).Mod(r, mi)
+		f, _ := new(big.Float).SetInt(r).Float64()
+		return math.Ldexp(f, mexp-53)
+	}
+	bx := new(big.Rat).SetInt(xi)
+	if e := xexp + k - 53; e >= 0 {
+		bx.SetInt(new(big.Int).Lsh(xi, uint(e)))
+	} else {
+		bx.SetFrac(xi, new(big.Int).Lsh(big.NewInt(1), uint(-e)))
+	}
+	f, _ := modulartest.CongruentRat(bx, new(big.Rat).SetFloat64(m)).Float64()
+	return f
+}
+
+func TestModulus_ScaleModRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < randomTestNum/4; i++ {
+		mod := math.Abs(randomFloat64(r))
+		n := randomFloat64(r)
+		k := r.Intn(4000) - 2000
+		if r.Intn(4) == 0 {
+			k = int(r.Int63n(1 << 40))
+		}
+		if mod == 0 {
+			continue
+		}
+
+		m := modular.NewModulus(mod)
+		if got, want := m.ScaleMod(n, k), bigScaleMod(n, k, mod); got != want {
+			t.Fatalf("Modulus{%v}.ScaleMod(%v, %v) = %v, want %v", mod, n, k, got, want)
+		}
+	}
+}
@@ -0,0 +1,271 @@
+package modular
+
+// NewVec2Modulus creates a new 2d Vector Modulus
+func NewVec2Modulus[T Float, V ~[2]T](vec V) Vec2Modulus[T, V] {
+	return Vec2Modulus[T, V]{
+		x: NewModulus(vec[0]),
+		y: NewModulus(vec[1]),
+	}
+}
+
+// Vec2Modulus defines a modulus for 2d vectors
+type Vec2Modulus[T Float, V ~[2]T] struct {
+	x Modulus[T]
+	y Modulus[T]
+}
+
+// Congruent performs Congruent() on all axis
+func (m Vec2Modulus[T, V]) Congruent(vec V) V {
+	return V{
+		m.x.Congruent(vec[0]),
+		m.y.Congruent(vec[1]),
+	}
+}
+
+// CongruentSlice performs Congruent() on all axis of each vector in src, storing them in dst.
+// dst must be at least as long as src, and may be src itself.
+func (m Vec2Modulus[T, V]) CongruentSlice(dst, src []V) {
+	dst = dst[:len(src)]
+	mx, my := m.x, m.y
+	for i, vec := range src {
+		dst[i] = V{
+			mx.Congruent(vec[0]),
+			my.Congruent(vec[1]),
+		}
+	}
+}
+
+// CongruentInPlace performs Congruent() on all axis of each vector in s.
+func (m Vec2Modulus[T, V]) CongruentInPlace(s []V) {
+	m.CongruentSlice(s, s)
+}
+
+// Dist returns the distance and direction of v1 to v2
+// It picks the shortest distance.
+func (m Vec2Modulus[T, V]) Dist(v1, v2 V) V {
+	return V{
+		m.x.Dist(v1[0], v2[0]),
+		m.y.Dist(v1[1], v2[1]),
+	}
+}
+
+// GetCongruent returns the vector closest to v1 that is congruent to v2
+func (m Vec2Modulus[T, V]) GetCongruent(v1, v2 V) V {
+	return V{
+		m.x.GetCongruent(v1[0], v2[0]),
+		m.y.GetCongruent(v1[1], v2[1]),
+	}
+}
+
+// FloorMultiple performs FloorMultiple() on all axis
+func (m Vec2Modulus[T, V]) FloorMultiple(vec V) V {
+	return V{
+		m.x.FloorMultiple(vec[0]),
+		m.y.FloorMultiple(vec[1]),
+	}
+}
+
+// CeilMultiple performs CeilMultiple() on all axis
+func (m Vec2Modulus[T, V]) CeilMultiple(vec V) V {
+	return V{
+		m.x.CeilMultiple(vec[0]),
+		m.y.CeilMultiple(vec[1]),
+	}
+}
+
+// RoundMultiple performs RoundMultiple() on all axis
+func (m Vec2Modulus[T, V]) RoundMultiple(vec V, tie TieBreak) V {
+	return V{
+		m.x.RoundMultiple(vec[0], tie),
+		m.y.RoundMultiple(vec[1], tie),
+	}
+}
+
+// NewVec3Modulus creates a new 3d Vector Modulus
+func NewVec3Modulus[T Float, V ~[3]T](vec V) Vec3Modulus[T, V] {
+	return Vec3Modulus[T, V]{
+		x: NewModulus(vec[0]),
+		y: NewModulus(vec[1]),
+		z: NewModulus(vec[2]),
+	}
+}
+
+// Vec3Modulus defines a modulus for 3d vectors
+type Vec3Modulus[T Float, V ~[3]T] struct {
+	x Modulus[T]
+	y Modulus[T]
+	z Modulus[T]
+}
+
+// Congruent performs Congruent() on all axis
+func (m Vec3Modulus[T, V]) Congruent(vec V) V {
+	return V{
+		m.x.Congruent(vec[0]),
+		m.y.Congruent(vec[1]),
+		m.z.Congruent(vec[2]),
+	}
+}
+
+// CongruentSlice performs Congruent() on all axis of each vector in src, storing them in dst.
+// dst must be at least as long as src, and may be src itself.
+func (m Vec3Modulus[T, V]) CongruentSlice(dst, src []V) {
+	dst = dst[:len(src)]
+	mx, my, mz := m.x, m.y, m.z
+	for i, vec := range src {
+		dst[i] = V{
+			mx.Congruent(vec[0]),
+			my.Congruent(vec[1]),
+			mz.Congruent(vec[2]),
+		}
+	}
+}
+
+// CongruentInPlace performs Congruent() on all axis of each vector in s.
+func (m Vec3Modulus[T, V]) CongruentInPlace(s []V) {
+	m.CongruentSlice(s, s)
+}
+
+// Dist returns the distance and direction of v1 to v2
+// It picks the shortest distance.
+func (m Vec3Modulus[T, V]) Dist(v1, v2 V) V {
+	return V{
+		m.x.Dist(v1[0], v2[0]),
+		m.y.Dist(v1[1], v2[1]),
+		m.z.Dist(v1[2], v2[2]),
+	}
+}
+
+// GetCongruent returns the vector closest to v1 that is congruent to v2
+func (m Vec3Modulus[T, V]) GetCongruent(v1, v2 V) V {
+	return V{
+		m.x.GetCongruent(v1[0], v2[0]),
+		m.y.GetCongruent(v1[1], v2[1]),
+		m.z.GetCongruent(v1[2], v2[2]),
+	}
+}
+
+// FloorMultiple performs FloorMultiple() on all axis
+func (m Vec3Modulus[T, V]) FloorMultiple(vec V) V {
+	return V{
+		m.x.FloorMultiple(vec[0]),
+		m.y.FloorMultiple(vec[1]),
+		m.z.FloorMultiple(vec[2]),
+	}
+}
+
+// CeilMultiple performs CeilMultiple() on all axis
+func (m Vec3Modulus[T, V]) CeilMultiple(vec V) V {
+	return V{
+		m.x.CeilMultiple(vec[0]),
+		m.y.CeilMultiple(vec[1]),
+		m.z.CeilMultiple(vec[2]),
+	}
+}
+
+// RoundMultiple performs RoundMultiple() on all axis
+func (m Vec3Modulus[T, V]) RoundMultiple(vec V, tie TieBreak) V {
+	return V{
+		m.x.RoundMultiple(vec[0], tie),
+		m.y.RoundMultiple(vec[1], tie),
+		m.z.RoundMultiple(vec[2], tie),
+	}
+}
+
+// NewVec4Modulus creates a new 4d Vector Modulus
+func NewVec4Modulus[T Float, V ~[4]T](vec V) Vec4Modulus[T, V] {
+	return Vec4Modulus[T, V]{
+		x: NewModulus(vec[0]),
+		y: NewModulus(vec[1]),
+		z: NewModulus(vec[2]),
+		w: NewModulus(vec[3]),
+	}
+}
+
+// Vec4Modulus defines a modulus for 4d vectors
+type Vec4Modulus[T Float, V ~[4]T] struct {
+	x Modulus[T]
+	y Modulus[T]
+	z Modulus[T]
+	w Modulus[T]
+}
+
+// Congruent performs Congruent() on all axis
+func (m Vec4Modulus[T, V]) Congruent(vec V) V {
+	return V{
+		m.x.Congruent(vec[0]),
+		m.y.Congruent(vec[1]),
+		m.z.Congruent(vec[2]),
+		m.w.Congruent(vec[3]),
+	}
+}
+
+// CongruentSlice performs Congruent() on all axis of each vector in src, storing them in dst.
+// dst must be at least as long as src, and may be src itself.
+func (m Vec4Modulus[T, V]) CongruentSlice(dst, src []V) {
+	dst = dst[:len(src)]
+	mx, my, mz, mw := m.x, m.y, m.z, m.w
+	for i, vec := range src {
+		dst[i] = V{
+			mx.Congruent(vec[0]),
+			my.Congruent(vec[1]),
+			mz.Congruent(vec[2]),
+			mw.Congruent(vec[3]),
+		}
+	}
+}
+
+// CongruentInPlace performs Congruent() on all axis of each vector in s.
+func (m Vec4Modulus[T, V]) CongruentInPlace(s []V) {
+	m.CongruentSlice(s, s)
+}
+
+// Dist returns the distance and direction of v1 to v2
+// It picks the shortest distance.
+func (m Vec4Modulus[T, V]) Dist(v1, v2 V) V {
+	return V{
+		m.x.Dist(v1[0], v2[0]),
+		m.y.Dist(v1[1], v2[1]),
+		m.z.Dist(v1[2], v2[2]),
+		m.w.Dist(v1[3], v2[3]),
+	}
+}
+
+// GetCongruent returns the vector closest to v1 that is congruent to v2
+func (m Vec4Modulus[T, V]) GetCongruent(v1, v2 V) V {
+	return V{
+		m.x.GetCongruent(v1[0], v2[0]),
+		m.y.GetCongruent(v1[1], v2[1]),
+		m.z.GetCongruent(v1[2], v2[2]),
+		m.w.GetCongruent(v1[3], v2[3]),
+	}
+}
+
+// FloorMultiple performs FloorMultiple() on all axis
+func (m Vec4Modulus[T, V]) FloorMultiple(vec V) V {
+	return V{
+		m.x.FloorMultiple(vec[0]),
+		m.y.FloorMultiple(vec[1]),
+		m.z.FloorMultiple(vec[2]),
+		m.w.FloorMultiple(vec[3]),
+	}
+}
+
+// CeilMultiple performs CeilMultiple() on all axis
+func (m Vec4Modulus[T, V]) CeilMultiple(vec V) V {
+	return V{
+		m.x.CeilMultiple(vec[0]),
+		m.y.CeilMultiple(vec[1]),
+		m.z.CeilMultiple(vec[2]),
+		m.w.CeilMultiple(vec[3]),
+	}
+}
+
+// RoundMultiple performs RoundMultiple() on all axis
+func (m Vec4Modulus[T, V]) RoundMultiple(vec V, tie TieBreak) V {
+	return V{
+		m.x.RoundMultiple(vec[0], tie),
+		m.y.RoundMultiple(vec[1], tie),
+		m.z.RoundMultiple(vec[2], tie),
+		m.w.RoundMultiple(vec[3], tie),
+	}
+}